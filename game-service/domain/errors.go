@@ -9,5 +9,6 @@ var (
 	ErrForbidden         = errors.New("forbidden")
 	ErrNotFound          = errors.New("not found")
 	ErrConflict          = errors.New("conflict")
+	ErrTooManyRequests   = errors.New("too many requests")
 	ErrInternal          = errors.New("Internal")
 )
@@ -0,0 +1,103 @@
+package domain
+
+// GameMode, game_modes tablosundaki bir oyun modunun katalog kaydıdır.
+type GameMode struct {
+	ID              int             `json:"id"`
+	Name            string          `json:"name"`
+	Description     string          `json:"description"`
+	MinPlayers      int             `json:"min_players"`
+	MaxPlayers      int             `json:"max_players"`
+	Available       bool            `json:"available"` // Sunucuda bu mod için bir oyun motoru var mı
+	DefaultSettings RoomSettings    `json:"default_settings"`
+	SettingsSchema  []SettingSchema `json:"settings_schema"`
+}
+
+// Ayar şemasındaki alan tipleri
+const (
+	SettingTypeInteger = "integer"
+	SettingTypeNumber  = "number"
+	SettingTypeBoolean = "boolean"
+	SettingTypeEnum    = "enum"
+)
+
+// SettingSchema, istemcinin ayar formunu oluşturabilmesi için bir ayarın tipini ve sınırlarını tanımlar.
+type SettingSchema struct {
+	Key     string   `json:"key"`
+	Type    string   `json:"type"`
+	Min     *float64 `json:"min,omitempty"`
+	Max     *float64 `json:"max,omitempty"`
+	Options []string `json:"options,omitempty"`
+}
+
+// NewGameMode, modun sınırlarından varsayılan ayarları ve ayar şemasını türeterek katalog kaydını oluşturur.
+func NewGameMode(id int, name, description string, limits GameModeLimits) GameMode {
+	defaults := DefaultRoomSettings(limits, limits.MaxPlayers)
+	defaults.GameModeID = id
+	defaults.ModeName = name
+
+	return GameMode{
+		ID:              id,
+		Name:            name,
+		Description:     description,
+		MinPlayers:      limits.MinPlayers,
+		MaxPlayers:      limits.MaxPlayers,
+		DefaultSettings: defaults,
+		SettingsSchema:  SettingsSchema(limits),
+	}
+}
+
+// Limits, modun doğrulamada kullanılan sınırlarını döner.
+func (m GameMode) Limits() GameModeLimits {
+	return GameModeLimits{
+		MinPlayers:           m.MinPlayers,
+		MaxPlayers:           m.MaxPlayers,
+		DefaultRounds:        m.DefaultSettings.TotalRounds,
+		DefaultRoundDuration: m.DefaultSettings.RoundDuration,
+	}
+}
+
+// SettingsSchema, RoomSettings.Validate ile aynı sınırları istemciye tarif eder.
+func SettingsSchema(limits GameModeLimits) []SettingSchema {
+	numeric := func(key, typ string, min, max float64) SettingSchema {
+		return SettingSchema{Key: key, Type: typ, Min: &min, Max: &max}
+	}
+	boolean := func(key string) SettingSchema {
+		return SettingSchema{Key: key, Type: SettingTypeBoolean}
+	}
+	enum := func(key string, options ...string) SettingSchema {
+		return SettingSchema{Key: key, Type: SettingTypeEnum, Options: options}
+	}
+
+	return []SettingSchema{
+		numeric("total_rounds", SettingTypeInteger, MinTotalRounds, MaxTotalRounds),
+		numeric("round_duration", SettingTypeInteger, MinRoundDuration, MaxRoundDuration),
+		numeric("preparation_duration", SettingTypeInteger, 0, MaxPreparationDuration),
+		numeric("min_players", SettingTypeInteger, float64(limits.MinPlayers), float64(limits.MaxPlayers)),
+		numeric("max_players", SettingTypeInteger, float64(limits.MinPlayers), float64(limits.MaxPlayers)),
+		boolean("allow_spectators"),
+		boolean("spectator_chat"),
+		boolean("allow_late_join"),
+		enum("late_join_score", LateJoinScoreZero, LateJoinScoreMin),
+		numeric("max_pause_duration", SettingTypeInteger, MinPauseDuration, MaxPauseDuration),
+		enum("pause_timeout_action", PauseTimeoutResume, PauseTimeoutEnd),
+		// Üst sınır hariçtir (oran 1'den küçük olmalı)
+		numeric("vote_kick_majority", SettingTypeNumber, 0.5, 0.99),
+		numeric("vote_kick_duration", SettingTypeInteger, MinVoteKickDuration, MaxVoteKickDuration),
+		numeric("vote_kick_cooldown", SettingTypeInteger, 0, MaxVoteKickCooldown),
+		boolean("require_ready"),
+		numeric("auto_start_countdown", SettingTypeInteger, 0, MaxAutoStartCountdown),
+		numeric("skip_penalty", SettingTypeInteger, 0, MaxSkipPenalty),
+		// 0 kapalı demektir; diğer değerler MinDrawerIdleTimeout'tan küçük olamaz
+		numeric("drawer_idle_timeout", SettingTypeInteger, 0, MaxDrawerIdleTimeout),
+		numeric("afk_rounds_limit", SettingTypeInteger, 0, MaxAfkRoundsLimit),
+		enum("word_source", WordSourceBuiltin, WordSourcePlayers),
+		numeric("words_per_player", SettingTypeInteger, MinWordsPerPlayer, MaxWordsPerPlayer),
+		numeric("word_submission_time", SettingTypeInteger, MinWordSubmissionTime, MaxWordSubmissionTime),
+		numeric("team_count", SettingTypeInteger, MinTeamCount, MaxTeamCount),
+		enum("team_assignment", TeamAssignmentAuto, TeamAssignmentHost),
+		numeric("steal_delay", SettingTypeInteger, 0, MaxStealDelay),
+		numeric("describe_duration", SettingTypeInteger, MinDescribeDuration, MaxDescribeDuration),
+		numeric("voting_duration", SettingTypeInteger, MinVotingDuration, MaxVotingDuration),
+		enum("canvas_layout", CanvasLayoutFree, CanvasLayoutTiles, CanvasLayoutLayers),
+	}
+}
@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GameSummary, biten bir oyunun sonuç ekranı için hazırlanan ve kalıcı olarak saklanan özetidir.
+type GameSummary struct {
+	ID           uuid.UUID       `json:"id"`
+	RoomID       uuid.UUID       `json:"room_id"`
+	GameModeID   int             `json:"game_mode_id"`
+	ModeName     string          `json:"mode_name"`
+	Completed    bool            `json:"completed"` // false: oyun tüm turlar bitmeden sonlandırıldı
+	TotalRounds  int             `json:"total_rounds"`
+	StartedAt    time.Time       `json:"started_at"`
+	EndedAt      time.Time       `json:"ended_at"`
+	Rankings     []PlayerRanking `json:"rankings"`
+	Rounds       []RoundSummary  `json:"rounds"`
+	FastestGuess *GuessRecord    `json:"fastest_guess,omitempty"`
+	BestDrawer   *DrawerAward    `json:"best_drawer,omitempty"`
+	Teams        []TeamResult    `json:"teams,omitempty"` // Sadece takımlı modlarda dolu
+}
+
+// PlayerRanking, bir oyuncunun final sıralamasıdır. Eşit puanlı oyuncular aynı sırayı paylaşır.
+type PlayerRanking struct {
+	Rank           int       `json:"rank"`
+	UserID         uuid.UUID `json:"user_id"`
+	Username       string    `json:"username"`
+	Score          int       `json:"score"`
+	RoundPoints    []int     `json:"round_points"` // Tur sırasına göre o turda kazanılan puan
+	CorrectGuesses int       `json:"correct_guesses"`
+	Winner         bool      `json:"winner"`
+}
+
+// RoundSummary, bir turun kelimesini, çizerini ve doğru tahminleri içerir.
+type RoundSummary struct {
+	Round          int               `json:"round"`
+	Word           string            `json:"word"`
+	DrawerID       uuid.UUID         `json:"drawer_id"`
+	DrawerUsername string            `json:"drawer_username"`
+	SubmittedBy    *uuid.UUID        `json:"submitted_by,omitempty"` // Kelime bir oyuncu tarafından yazıldıysa
+	Guesses        []GuessRecord     `json:"guesses"`
+	Points         map[uuid.UUID]int `json:"points"`
+}
+
+// GuessRecord, bir doğru tahmini ve turun başından itibaren geçen süreyi tutar.
+type GuessRecord struct {
+	Round     int       `json:"round"`
+	UserID    uuid.UUID `json:"user_id"`
+	Username  string    `json:"username"`
+	Order     int       `json:"order"` // Turda kaçıncı doğru tahmin olduğu (1'den başlar)
+	ElapsedMs int64     `json:"elapsed_ms"`
+}
+
+// DrawerAward, çizimleri en çok doğru tahmin edilen oyuncudur.
+type DrawerAward struct {
+	UserID         uuid.UUID `json:"user_id"`
+	Username       string    `json:"username"`
+	CorrectGuesses int       `json:"correct_guesses"`
+}
+
+// TeamResult, takımlı modlarda bir takımın final sonucu ve üyelerinin katkısıdır.
+type TeamResult struct {
+	TeamID  int                `json:"team_id"`
+	Name    string             `json:"name"`
+	Rank    int                `json:"rank"`
+	Score   int                `json:"score"`
+	Winner  bool               `json:"winner"`
+	Members []TeamContribution `json:"members"`
+}
+
+// TeamContribution, bir oyuncunun takım puanına katkısıdır.
+type TeamContribution struct {
+	UserID         uuid.UUID `json:"user_id"`
+	Username       string    `json:"username"`
+	Points         int       `json:"points"`          // Oyuncunun takıma kazandırdığı puan
+	CorrectGuesses int       `json:"correct_guesses"` // Kendi takımının çizimlerinde doğru tahmin
+	Steals         int       `json:"steals"`          // Rakip takımın çizimlerinde doğru tahmin
+	DrawnRounds    int       `json:"drawn_rounds"`
+}
+
+// Finalize, tur kayıtlarından sıralamayı, tur puanlarını, en hızlı tahmini ve en iyi çizeri hesaplar.
+// Rankings alanında oyuncuların toplam puanları (Score) doldurulmuş olmalıdır.
+func (s *GameSummary) Finalize() {
+	sort.Slice(s.Rounds, func(i, j int) bool { return s.Rounds[i].Round < s.Rounds[j].Round })
+
+	index := make(map[uuid.UUID]int, len(s.Rankings))
+	for i := range s.Rankings {
+		s.Rankings[i].RoundPoints = make([]int, len(s.Rounds))
+		s.Rankings[i].CorrectGuesses = 0
+		index[s.Rankings[i].UserID] = i
+	}
+
+	drawerGuesses := make(map[uuid.UUID]int)
+	usernames := make(map[uuid.UUID]string)
+	s.FastestGuess = nil
+	for r, round := range s.Rounds {
+		for userID, points := range round.Points {
+			if i, ok := index[userID]; ok {
+				s.Rankings[i].RoundPoints[r] = points
+			}
+		}
+		for g := range round.Guesses {
+			guess := round.Guesses[g]
+			if i, ok := index[guess.UserID]; ok {
+				s.Rankings[i].CorrectGuesses++
+			}
+			if s.FastestGuess == nil || guess.ElapsedMs < s.FastestGuess.ElapsedMs {
+				s.FastestGuess = &guess
+			}
+		}
+		if round.DrawerID != uuid.Nil {
+			drawerGuesses[round.DrawerID] += len(round.Guesses)
+			usernames[round.DrawerID] = round.DrawerUsername
+		}
+	}
+
+	// Eşitlikte önce isme göre sıralanır ki sonuç her seferinde aynı olsun
+	sort.SliceStable(s.Rankings, func(i, j int) bool {
+		if s.Rankings[i].Score != s.Rankings[j].Score {
+			return s.Rankings[i].Score > s.Rankings[j].Score
+		}
+		return s.Rankings[i].Username < s.Rankings[j].Username
+	})
+	topScore := 0
+	if len(s.Rankings) > 0 {
+		topScore = s.Rankings[0].Score
+	}
+	for i := range s.Rankings {
+		// Standart yarışma sıralaması: 1, 1, 3
+		if i > 0 && s.Rankings[i].Score == s.Rankings[i-1].Score {
+			s.Rankings[i].Rank = s.Rankings[i-1].Rank
+		} else {
+			s.Rankings[i].Rank = i + 1
+		}
+		s.Rankings[i].Winner = s.Completed && topScore > 0 && s.Rankings[i].Score == topScore
+	}
+	if len(s.Teams) > 0 {
+		s.finalizeTeams()
+	}
+
+	s.BestDrawer = nil
+	for userID, count := range drawerGuesses {
+		if count == 0 {
+			continue
+		}
+		best := s.BestDrawer
+		if best == nil || count > best.CorrectGuesses ||
+			(count == best.CorrectGuesses && usernames[userID] < best.Username) {
+			s.BestDrawer = &DrawerAward{UserID: userID, Username: usernames[userID], CorrectGuesses: count}
+		}
+	}
+}
+
+// finalizeTeams, takımları puana göre sıralar. Takımlı modlarda kazanan, en yüksek puanlı
+// takım(lar)ın üyeleridir; bireysel kazanan işaretleri buna göre düzeltilir.
+func (s *GameSummary) finalizeTeams() {
+	sort.SliceStable(s.Teams, func(i, j int) bool {
+		if s.Teams[i].Score != s.Teams[j].Score {
+			return s.Teams[i].Score > s.Teams[j].Score
+		}
+		return s.Teams[i].TeamID < s.Teams[j].TeamID
+	})
+
+	winners := make(map[uuid.UUID]bool)
+	topScore := s.Teams[0].Score
+	for i := range s.Teams {
+		if i > 0 && s.Teams[i].Score == s.Teams[i-1].Score {
+			s.Teams[i].Rank = s.Teams[i-1].Rank
+		} else {
+			s.Teams[i].Rank = i + 1
+		}
+		s.Teams[i].Winner = s.Completed && topScore > 0 && s.Teams[i].Score == topScore
+		if s.Teams[i].Winner {
+			for _, m := range s.Teams[i].Members {
+				winners[m.UserID] = true
+			}
+		}
+	}
+	for i := range s.Rankings {
+		s.Rankings[i].Winner = winners[s.Rankings[i].UserID]
+	}
+}
@@ -0,0 +1,91 @@
+package domain
+
+import "fmt"
+
+// RoomRole, kullanıcının odadaki yetki seviyesini belirtir.
+type RoomRole string
+
+const (
+	RoleHost      RoomRole = "host"
+	RoleCoHost    RoomRole = "co_host"
+	RolePlayer    RoomRole = "player"
+	RoleSpectator RoomRole = "spectator"
+)
+
+// Permission, rol kontrolü gerektiren oda işlemleridir.
+type Permission string
+
+const (
+	PermStartGame      Permission = "start_game"
+	PermChangeSettings Permission = "change_settings"
+	PermChangeMode     Permission = "change_mode"
+	PermKickPlayer     Permission = "kick_player"
+	PermBanPlayer      Permission = "ban_player"
+	PermPauseGame      Permission = "pause_game"
+	PermManageRoles    Permission = "manage_roles"
+	PermRegenerateCode Permission = "regenerate_code"
+	PermManageInvites  Permission = "manage_invites"
+)
+
+var rolePermissions = map[RoomRole]map[Permission]bool{
+	RoleHost: {
+		PermStartGame:      true,
+		PermChangeSettings: true,
+		PermChangeMode:     true,
+		PermKickPlayer:     true,
+		PermBanPlayer:      true,
+		PermPauseGame:      true,
+		PermManageRoles:    true,
+		PermRegenerateCode: true,
+		PermManageInvites:  true,
+	},
+	RoleCoHost: {
+		PermStartGame:      true,
+		PermChangeSettings: true,
+		PermChangeMode:     true,
+		PermKickPlayer:     true,
+		PermBanPlayer:      true,
+		PermPauseGame:      true,
+	},
+}
+
+var roleRanks = map[RoomRole]int{
+	RoleHost:      3,
+	RoleCoHost:    2,
+	RolePlayer:    1,
+	RoleSpectator: 0,
+}
+
+// Can, rolün verilen işlemi yapmaya yetkili olup olmadığını döner.
+func (r RoomRole) Can(p Permission) bool {
+	return rolePermissions[r][p]
+}
+
+// Outranks, rolün diğer rolden daha yetkili olup olmadığını döner.
+// Moderasyonda kişi yalnızca kendisinden düşük roldekileri atabilir/banlayabilir.
+func (r RoomRole) Outranks(other RoomRole) bool {
+	return roleRanks[r] > roleRanks[other]
+}
+
+// PermissionError, yetkisiz işlem denemelerini yapılandırılmış olarak taşır.
+// errors.Is(err, ErrForbidden) ile yakalanabilir.
+type PermissionError struct {
+	Action Permission `json:"action"`
+	Role   RoomRole   `json:"role"`
+	Reason string     `json:"reason,omitempty"`
+}
+
+func NewPermissionError(role RoomRole, action Permission) *PermissionError {
+	return &PermissionError{Action: action, Role: role}
+}
+
+func (e *PermissionError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("%s: %s", ErrForbidden, e.Reason)
+	}
+	return fmt.Sprintf("%s: role %q is not allowed to %s", ErrForbidden, e.Role, e.Action)
+}
+
+func (e *PermissionError) Unwrap() error {
+	return ErrForbidden
+}
@@ -6,6 +6,13 @@ import (
 	"github.com/google/uuid"
 )
 
+// Oda durumları; oyun yaşam döngüsüne göre hub tarafından güncellenir.
+const (
+	RoomStatusWaiting  = "waiting"
+	RoomStatusPlaying  = "playing"
+	RoomStatusFinished = "finished"
+)
+
 type Room struct {
 	ID             uuid.UUID `json:"id"`
 	RoomName       string    `json:"room_name"`
@@ -16,9 +23,53 @@ type Room struct {
 	GameModeID     int       `json:"game_mode_id"`
 	IsPrivate      bool      `json:"is_private"`
 	RoomCode       string    `json:"room_code,omitempty"` // Gizli olabileceğinden omitempty ekledik
+	LanguageCode   string    `json:"language_code"`
 	CreatedAt      time.Time `json:"created_at"`
 	StartedAt      time.Time `json:"started_at,omitempty"`
 	FinishedAt     time.Time `json:"finished_at,omitempty"`
 	ModeName       string    `json:"mode_name"`
 	IsUserInRoom   bool      `json:"is_user_in_room"`
 }
+
+type BannedPlayer struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+	BannedAt time.Time `json:"banned_at"`
+}
+
+// RoomMember, odadaki bir üyeliği (oda, kullanıcı) tanımlar.
+type RoomMember struct {
+	RoomID uuid.UUID `json:"room_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+// RoomInvite, odaya süreli ve sınırlı kullanımlık davet bağlantısıdır.
+type RoomInvite struct {
+	ID        uuid.UUID `json:"id"`
+	RoomID    uuid.UUID `json:"room_id"`
+	Token     string    `json:"token"`
+	CreatedBy uuid.UUID `json:"created_by"`
+	ExpiresAt time.Time `json:"expires_at"`
+	MaxUses   int       `json:"max_uses"`
+	Uses      int       `json:"uses"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Oda listesi sıralama seçenekleri
+const (
+	RoomSortNewest       = "newest"         // En yeni oda önce
+	RoomSortFullest      = "fullest"        // En kalabalık oda önce
+	RoomSortAboutToStart = "about_to_start" // Dolmasına en az koltuk kalan oda önce
+)
+
+// RoomListFilter, oda listesinde filtreleme, arama, sıralama ve sayfalama seçenekleridir.
+type RoomListFilter struct {
+	GameModeID   int
+	LanguageCode string
+	HasFreeSeats bool
+	OnlyPrivate  bool // Sadece kullanıcının üyesi olduğu gizli odalar
+	Search       string
+	Sort         string
+	Cursor       string
+	Limit        int
+}
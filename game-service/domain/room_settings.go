@@ -0,0 +1,332 @@
+package domain
+
+import "fmt"
+
+// Geç katılan oyuncunun başlangıç puanı seçenekleri.
+const (
+	LateJoinScoreZero = "zero"
+	LateJoinScoreMin  = "min"
+)
+
+// Duraklatma süresi dolduğunda uygulanacak işlem seçenekleri.
+const (
+	PauseTimeoutResume = "resume"
+	PauseTimeoutEnd    = "end"
+)
+
+// Çizilecek kelimelerin kaynağı.
+const (
+	WordSourceBuiltin = "builtin" // Sunucunun kelime listesi
+	WordSourcePlayers = "players" // Oyun öncesi oyuncuların yazdığı kelimeler
+)
+
+// Takım modunda oyuncuların takımlara dağıtılma şekli.
+const (
+	TeamAssignmentAuto = "auto" // Sunucu takımları dengeler
+	TeamAssignmentHost = "host" // Host lobide oyuncuları takımlara yerleştirir
+)
+
+// Ortak Alan modunda tuvalin oyunculara paylaştırılma şekli.
+const (
+	CanvasLayoutFree   = "free"   // Herkes tuvalin her yerine çizebilir
+	CanvasLayoutTiles  = "tiles"  // Tuval karolara bölünür; her oyuncu sadece kendi karosuna çizer
+	CanvasLayoutLayers = "layers" // Her oyuncu kendi katmanına çizer
+)
+
+// Oda ayarlarının moddan bağımsız sınırları.
+const (
+	MinTotalRounds         = 1
+	MaxTotalRounds         = 20
+	MinRoundDuration       = 15
+	MaxRoundDuration       = 600
+	MaxPreparationDuration = 30
+	MinPauseDuration       = 10
+	MaxPauseDuration       = 600
+	MinVoteKickDuration    = 10
+	MaxVoteKickDuration    = 120
+	MaxVoteKickCooldown    = 600
+	MaxAutoStartCountdown  = 60
+	MaxSkipPenalty         = 50
+	MinDrawerIdleTimeout   = 10
+	MaxDrawerIdleTimeout   = 120
+	MaxAfkRoundsLimit      = 10
+	MinWordsPerPlayer      = 1
+	MaxWordsPerPlayer      = 5
+	MinWordSubmissionTime  = 15
+	MaxWordSubmissionTime  = 180
+	MinTeamCount           = 2
+	MaxTeamCount           = 4
+	MaxStealDelay          = 120
+	MinDescribeDuration    = 10
+	MaxDescribeDuration    = 120
+	MinVotingDuration      = 10
+	MaxVotingDuration      = 120
+)
+
+// RoomSettings, bir odanın oyun ayarlarıdır. rooms.settings kolonunda JSON olarak saklanır;
+// GameModeID ve ModeName her okumada rooms/game_modes tablolarından doldurulur.
+type RoomSettings struct {
+	GameModeID          int     `json:"game_mode_id"`
+	ModeName            string  `json:"mode_name"`
+	TotalRounds         int     `json:"total_rounds"`
+	RoundDuration       int     `json:"round_duration"` // saniye cinsinden
+	PreparationDuration int     `json:"preparation_duration"`
+	MaxPlayers          int     `json:"max_players"`
+	MinPlayers          int     `json:"min_players"`
+	AllowSpectators     bool    `json:"allow_spectators"`
+	SpectatorChat       bool    `json:"spectator_chat"`
+	AllowLateJoin       bool    `json:"allow_late_join"`
+	LateJoinScore       string  `json:"late_join_score"`
+	MaxPauseDuration    int     `json:"max_pause_duration"`
+	PauseTimeoutAction  string  `json:"pause_timeout_action"`
+	VoteKickMajority    float64 `json:"vote_kick_majority"`
+	VoteKickDuration    int     `json:"vote_kick_duration"`
+	VoteKickCooldown    int     `json:"vote_kick_cooldown"`
+	RequireReady        bool    `json:"require_ready"`
+	AutoStartCountdown  int     `json:"auto_start_countdown"`
+	SkipPenalty         int     `json:"skip_penalty"`         // Sırasını pas geçen çizerden düşülecek puan
+	DrawerIdleTimeout   int     `json:"drawer_idle_timeout"`  // Çizer bu kadar saniye çizmezse tur biter (0 = kapalı)
+	AfkRoundsLimit      int     `json:"afk_rounds_limit"`     // Üst üste bu kadar AFK turdan sonra çizer sırasından çıkarılır (0 = kapalı)
+	WordSource          string  `json:"word_source"`          // "builtin" veya "players"
+	WordsPerPlayer      int     `json:"words_per_player"`     // Kelime toplama aşamasında oyuncu başına kelime sayısı
+	WordSubmissionTime  int     `json:"word_submission_time"` // Kelime toplama aşamasının süresi (saniye)
+	TeamCount           int     `json:"team_count"`           // Takım modunda takım sayısı
+	TeamAssignment      string  `json:"team_assignment"`      // "auto" veya "host"
+	StealDelay          int     `json:"steal_delay"`          // Diğer takımların tahmin edebilmesi için beklenen süre (saniye, 0 = çalma kapalı)
+	DescribeDuration    int     `json:"describe_duration"`    // Kırık Telefon modunda yazma/anlatma aşamalarının süresi (saniye)
+	VotingDuration      int     `json:"voting_duration"`      // Çizim Yarışması modunda oylama aşamasının süresi (saniye)
+	CanvasLayout        string  `json:"canvas_layout"`        // Ortak Alan modunda "free", "tiles" veya "layers"
+}
+
+// RoomSettingsPatch, kısmi ayar güncellemesidir; nil alanlar değiştirilmez.
+type RoomSettingsPatch struct {
+	TotalRounds         *int     `json:"total_rounds"`
+	RoundDuration       *int     `json:"round_duration"`
+	PreparationDuration *int     `json:"preparation_duration"`
+	MaxPlayers          *int     `json:"max_players"`
+	MinPlayers          *int     `json:"min_players"`
+	AllowSpectators     *bool    `json:"allow_spectators"`
+	SpectatorChat       *bool    `json:"spectator_chat"`
+	AllowLateJoin       *bool    `json:"allow_late_join"`
+	LateJoinScore       *string  `json:"late_join_score"`
+	MaxPauseDuration    *int     `json:"max_pause_duration"`
+	PauseTimeoutAction  *string  `json:"pause_timeout_action"`
+	VoteKickMajority    *float64 `json:"vote_kick_majority"`
+	VoteKickDuration    *int     `json:"vote_kick_duration"`
+	VoteKickCooldown    *int     `json:"vote_kick_cooldown"`
+	RequireReady        *bool    `json:"require_ready"`
+	AutoStartCountdown  *int     `json:"auto_start_countdown"`
+	SkipPenalty         *int     `json:"skip_penalty"`
+	DrawerIdleTimeout   *int     `json:"drawer_idle_timeout"`
+	AfkRoundsLimit      *int     `json:"afk_rounds_limit"`
+	WordSource          *string  `json:"word_source"`
+	WordsPerPlayer      *int     `json:"words_per_player"`
+	WordSubmissionTime  *int     `json:"word_submission_time"`
+	TeamCount           *int     `json:"team_count"`
+	TeamAssignment      *string  `json:"team_assignment"`
+	StealDelay          *int     `json:"steal_delay"`
+	DescribeDuration    *int     `json:"describe_duration"`
+	VotingDuration      *int     `json:"voting_duration"`
+	CanvasLayout        *string  `json:"canvas_layout"`
+}
+
+// GameModeLimits, bir oyun modunun oyuncu sınırları ve varsayılan tur ayarlarıdır.
+type GameModeLimits struct {
+	MinPlayers           int
+	MaxPlayers           int
+	DefaultRounds        int
+	DefaultRoundDuration int
+}
+
+// DefaultRoomSettings, ayarı hiç kaydedilmemiş bir oda için varsayılanları döner.
+func DefaultRoomSettings(limits GameModeLimits, roomMaxPlayers int) RoomSettings {
+	maxPlayers := roomMaxPlayers
+	if maxPlayers <= 0 || maxPlayers > limits.MaxPlayers {
+		maxPlayers = limits.MaxPlayers
+	}
+	return RoomSettings{
+		TotalRounds:         limits.DefaultRounds,
+		RoundDuration:       limits.DefaultRoundDuration,
+		PreparationDuration: 5,
+		MaxPlayers:          maxPlayers,
+		MinPlayers:          limits.MinPlayers,
+		AllowSpectators:     true,
+		SpectatorChat:       true,
+		LateJoinScore:       LateJoinScoreZero,
+		MaxPauseDuration:    120,
+		PauseTimeoutAction:  PauseTimeoutResume,
+		VoteKickMajority:    0.5,
+		VoteKickDuration:    30,
+		VoteKickCooldown:    60,
+		RequireReady:        true,
+		DrawerIdleTimeout:   30,
+		AfkRoundsLimit:      2,
+		WordSource:          WordSourceBuiltin,
+		WordsPerPlayer:      1,
+		WordSubmissionTime:  45,
+		TeamCount:           2,
+		TeamAssignment:      TeamAssignmentAuto,
+		StealDelay:          20,
+		DescribeDuration:    30,
+		VotingDuration:      30,
+		CanvasLayout:        CanvasLayoutFree,
+	}
+}
+
+// Apply, patch içindeki dolu alanları ayarlara uygular.
+func (s *RoomSettings) Apply(p RoomSettingsPatch) {
+	if p.TotalRounds != nil {
+		s.TotalRounds = *p.TotalRounds
+	}
+	if p.RoundDuration != nil {
+		s.RoundDuration = *p.RoundDuration
+	}
+	if p.PreparationDuration != nil {
+		s.PreparationDuration = *p.PreparationDuration
+	}
+	if p.MaxPlayers != nil {
+		s.MaxPlayers = *p.MaxPlayers
+	}
+	if p.MinPlayers != nil {
+		s.MinPlayers = *p.MinPlayers
+	}
+	if p.AllowSpectators != nil {
+		s.AllowSpectators = *p.AllowSpectators
+	}
+	if p.SpectatorChat != nil {
+		s.SpectatorChat = *p.SpectatorChat
+	}
+	if p.AllowLateJoin != nil {
+		s.AllowLateJoin = *p.AllowLateJoin
+	}
+	if p.LateJoinScore != nil {
+		s.LateJoinScore = *p.LateJoinScore
+	}
+	if p.MaxPauseDuration != nil {
+		s.MaxPauseDuration = *p.MaxPauseDuration
+	}
+	if p.PauseTimeoutAction != nil {
+		s.PauseTimeoutAction = *p.PauseTimeoutAction
+	}
+	if p.VoteKickMajority != nil {
+		s.VoteKickMajority = *p.VoteKickMajority
+	}
+	if p.VoteKickDuration != nil {
+		s.VoteKickDuration = *p.VoteKickDuration
+	}
+	if p.VoteKickCooldown != nil {
+		s.VoteKickCooldown = *p.VoteKickCooldown
+	}
+	if p.RequireReady != nil {
+		s.RequireReady = *p.RequireReady
+	}
+	if p.AutoStartCountdown != nil {
+		s.AutoStartCountdown = *p.AutoStartCountdown
+	}
+	if p.SkipPenalty != nil {
+		s.SkipPenalty = *p.SkipPenalty
+	}
+	if p.DrawerIdleTimeout != nil {
+		s.DrawerIdleTimeout = *p.DrawerIdleTimeout
+	}
+	if p.AfkRoundsLimit != nil {
+		s.AfkRoundsLimit = *p.AfkRoundsLimit
+	}
+	if p.WordSource != nil {
+		s.WordSource = *p.WordSource
+	}
+	if p.WordsPerPlayer != nil {
+		s.WordsPerPlayer = *p.WordsPerPlayer
+	}
+	if p.WordSubmissionTime != nil {
+		s.WordSubmissionTime = *p.WordSubmissionTime
+	}
+	if p.TeamCount != nil {
+		s.TeamCount = *p.TeamCount
+	}
+	if p.TeamAssignment != nil {
+		s.TeamAssignment = *p.TeamAssignment
+	}
+	if p.StealDelay != nil {
+		s.StealDelay = *p.StealDelay
+	}
+	if p.DescribeDuration != nil {
+		s.DescribeDuration = *p.DescribeDuration
+	}
+	if p.VotingDuration != nil {
+		s.VotingDuration = *p.VotingDuration
+	}
+	if p.CanvasLayout != nil {
+		s.CanvasLayout = *p.CanvasLayout
+	}
+}
+
+// ClampPlayers, oyuncu sınırlarını modun sınırlarına çeker (mod değiştiğinde eski ayarlar taşabilir).
+func (s *RoomSettings) ClampPlayers(limits GameModeLimits) {
+	if s.MaxPlayers > limits.MaxPlayers || s.MaxPlayers < limits.MinPlayers {
+		s.MaxPlayers = limits.MaxPlayers
+	}
+	if s.MinPlayers < limits.MinPlayers {
+		s.MinPlayers = limits.MinPlayers
+	}
+	if s.MinPlayers > s.MaxPlayers {
+		s.MinPlayers = s.MaxPlayers
+	}
+}
+
+// Validate, ayarları modun oyuncu sınırlarına ve genel sınırlara göre doğrular.
+func (s RoomSettings) Validate(limits GameModeLimits) error {
+	switch {
+	case s.MinPlayers < limits.MinPlayers || s.MinPlayers > limits.MaxPlayers:
+		return fmt.Errorf("%w: min_players must be between %d and %d for this game mode", ErrInvalidInput, limits.MinPlayers, limits.MaxPlayers)
+	case s.MaxPlayers < limits.MinPlayers || s.MaxPlayers > limits.MaxPlayers:
+		return fmt.Errorf("%w: max_players must be between %d and %d for this game mode", ErrInvalidInput, limits.MinPlayers, limits.MaxPlayers)
+	case s.MinPlayers > s.MaxPlayers:
+		return fmt.Errorf("%w: min_players cannot be greater than max_players", ErrInvalidInput)
+	case s.TotalRounds < MinTotalRounds || s.TotalRounds > MaxTotalRounds:
+		return fmt.Errorf("%w: total_rounds must be between %d and %d", ErrInvalidInput, MinTotalRounds, MaxTotalRounds)
+	case s.RoundDuration < MinRoundDuration || s.RoundDuration > MaxRoundDuration:
+		return fmt.Errorf("%w: round_duration must be between %d and %d seconds", ErrInvalidInput, MinRoundDuration, MaxRoundDuration)
+	case s.PreparationDuration < 0 || s.PreparationDuration > MaxPreparationDuration:
+		return fmt.Errorf("%w: preparation_duration must be between 0 and %d seconds", ErrInvalidInput, MaxPreparationDuration)
+	case s.LateJoinScore != LateJoinScoreZero && s.LateJoinScore != LateJoinScoreMin:
+		return fmt.Errorf("%w: late_join_score must be '%s' or '%s'", ErrInvalidInput, LateJoinScoreZero, LateJoinScoreMin)
+	case s.MaxPauseDuration < MinPauseDuration || s.MaxPauseDuration > MaxPauseDuration:
+		return fmt.Errorf("%w: max_pause_duration must be between %d and %d seconds", ErrInvalidInput, MinPauseDuration, MaxPauseDuration)
+	case s.PauseTimeoutAction != PauseTimeoutResume && s.PauseTimeoutAction != PauseTimeoutEnd:
+		return fmt.Errorf("%w: pause_timeout_action must be '%s' or '%s'", ErrInvalidInput, PauseTimeoutResume, PauseTimeoutEnd)
+	case s.VoteKickMajority < 0.5 || s.VoteKickMajority >= 1:
+		return fmt.Errorf("%w: vote_kick_majority must be at least 0.5 and less than 1", ErrInvalidInput)
+	case s.VoteKickDuration < MinVoteKickDuration || s.VoteKickDuration > MaxVoteKickDuration:
+		return fmt.Errorf("%w: vote_kick_duration must be between %d and %d seconds", ErrInvalidInput, MinVoteKickDuration, MaxVoteKickDuration)
+	case s.VoteKickCooldown < 0 || s.VoteKickCooldown > MaxVoteKickCooldown:
+		return fmt.Errorf("%w: vote_kick_cooldown must be between 0 and %d seconds", ErrInvalidInput, MaxVoteKickCooldown)
+	case s.AutoStartCountdown < 0 || s.AutoStartCountdown > MaxAutoStartCountdown:
+		return fmt.Errorf("%w: auto_start_countdown must be between 0 and %d seconds", ErrInvalidInput, MaxAutoStartCountdown)
+	case s.SkipPenalty < 0 || s.SkipPenalty > MaxSkipPenalty:
+		return fmt.Errorf("%w: skip_penalty must be between 0 and %d", ErrInvalidInput, MaxSkipPenalty)
+	case s.DrawerIdleTimeout != 0 && (s.DrawerIdleTimeout < MinDrawerIdleTimeout || s.DrawerIdleTimeout > MaxDrawerIdleTimeout):
+		return fmt.Errorf("%w: drawer_idle_timeout must be 0 (disabled) or between %d and %d seconds", ErrInvalidInput, MinDrawerIdleTimeout, MaxDrawerIdleTimeout)
+	case s.AfkRoundsLimit < 0 || s.AfkRoundsLimit > MaxAfkRoundsLimit:
+		return fmt.Errorf("%w: afk_rounds_limit must be between 0 and %d", ErrInvalidInput, MaxAfkRoundsLimit)
+	case s.WordSource != WordSourceBuiltin && s.WordSource != WordSourcePlayers:
+		return fmt.Errorf("%w: word_source must be '%s' or '%s'", ErrInvalidInput, WordSourceBuiltin, WordSourcePlayers)
+	case s.WordsPerPlayer < MinWordsPerPlayer || s.WordsPerPlayer > MaxWordsPerPlayer:
+		return fmt.Errorf("%w: words_per_player must be between %d and %d", ErrInvalidInput, MinWordsPerPlayer, MaxWordsPerPlayer)
+	case s.WordSubmissionTime < MinWordSubmissionTime || s.WordSubmissionTime > MaxWordSubmissionTime:
+		return fmt.Errorf("%w: word_submission_time must be between %d and %d seconds", ErrInvalidInput, MinWordSubmissionTime, MaxWordSubmissionTime)
+	case s.TeamCount < MinTeamCount || s.TeamCount > MaxTeamCount:
+		return fmt.Errorf("%w: team_count must be between %d and %d", ErrInvalidInput, MinTeamCount, MaxTeamCount)
+	case s.TeamAssignment != TeamAssignmentAuto && s.TeamAssignment != TeamAssignmentHost:
+		return fmt.Errorf("%w: team_assignment must be '%s' or '%s'", ErrInvalidInput, TeamAssignmentAuto, TeamAssignmentHost)
+	case s.StealDelay < 0 || s.StealDelay > MaxStealDelay:
+		return fmt.Errorf("%w: steal_delay must be between 0 and %d seconds", ErrInvalidInput, MaxStealDelay)
+	case s.DescribeDuration < MinDescribeDuration || s.DescribeDuration > MaxDescribeDuration:
+		return fmt.Errorf("%w: describe_duration must be between %d and %d seconds", ErrInvalidInput, MinDescribeDuration, MaxDescribeDuration)
+	case s.VotingDuration < MinVotingDuration || s.VotingDuration > MaxVotingDuration:
+		return fmt.Errorf("%w: voting_duration must be between %d and %d seconds", ErrInvalidInput, MinVotingDuration, MaxVotingDuration)
+	case s.CanvasLayout != CanvasLayoutFree && s.CanvasLayout != CanvasLayoutTiles && s.CanvasLayout != CanvasLayoutLayers:
+		return fmt.Errorf("%w: canvas_layout must be '%s', '%s' or '%s'", ErrInvalidInput, CanvasLayoutFree, CanvasLayoutTiles, CanvasLayoutLayers)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Oyuncuların yazdığı kelimelerin uzunluk sınırları (harf sayısı)
+const (
+	MinSubmittedWordLength = 2
+	MaxSubmittedWordLength = 30
+)
+
+// MaxChainTextLength, Kırık Telefon modunda yazılan cümle/anlatımların en fazla uzunluğudur.
+const MaxChainTextLength = 100
+
+// ErrWordNotAllowed, kelime küfür filtresine takıldığında döner.
+var ErrWordNotAllowed = fmt.Errorf("%w: word is not allowed", ErrInvalidInput)
+
+// blockedWords, oyuncu kelimelerinde kabul edilmeyen ifadelerin normalize edilmiş kökleridir.
+// Üç harften uzun kökler kelimenin içinde geçince de yakalanır (ekli halleri için).
+var blockedWords = []string{
+	"amk", "aq", "orospu", "piç", "sik", "yarrak", "göt", "ibne", "kahpe", "pezevenk",
+	"fuck", "shit", "bitch", "cunt", "dick", "nigger", "whore",
+}
+
+// blockedExceptions, engelli bir kök içerdiği halde masum olan yaygın kelimelerdir.
+var blockedExceptions = map[string]bool{
+	"dickens": true,
+}
+
+// NormalizeWord, kelimeyi karşılaştırma için normalize eder: baştaki/sondaki boşluklar atılır,
+// ara boşluklar teke indirilir ve Türkçe kurallarına göre küçük harfe çevrilir.
+func NormalizeWord(word string) string {
+	return strings.ToLowerSpecial(unicode.TurkishCase, strings.Join(strings.Fields(word), " "))
+}
+
+// ValidateSubmittedWord, oyuncunun yazdığı kelimeyi uzunluk, karakter ve küfür filtresine göre
+// denetler. Geçerliyse normalize edilmiş halini döner.
+func ValidateSubmittedWord(word string) (string, error) {
+	normalized := NormalizeWord(word)
+	length := utf8.RuneCountInString(normalized)
+	if length < MinSubmittedWordLength || length > MaxSubmittedWordLength {
+		return "", fmt.Errorf("%w: word must be between %d and %d characters", ErrInvalidInput, MinSubmittedWordLength, MaxSubmittedWordLength)
+	}
+	for _, r := range normalized {
+		if !unicode.IsLetter(r) && r != ' ' && r != '-' {
+			return "", fmt.Errorf("%w: word may only contain letters, spaces and hyphens", ErrInvalidInput)
+		}
+	}
+	if isBlockedWord(normalized) {
+		return "", ErrWordNotAllowed
+	}
+	return normalized, nil
+}
+
+// ValidateChainText, Kırık Telefon modunda yazılan cümleyi denetler. Harf büyüklüğü ve noktalama
+// korunur; sadece fazla boşluklar atılır. Geçerliyse temizlenmiş halini döner.
+func ValidateChainText(text string) (string, error) {
+	cleaned := strings.Join(strings.Fields(text), " ")
+	length := utf8.RuneCountInString(cleaned)
+	if length == 0 || length > MaxChainTextLength {
+		return "", fmt.Errorf("%w: text must be between 1 and %d characters", ErrInvalidInput, MaxChainTextLength)
+	}
+	// Noktalama işaretleri filtreyi atlatmasın diye harf olmayan karakterler boşluk sayılır
+	lettersOnly := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) {
+			return r
+		}
+		return ' '
+	}, cleaned)
+	if isBlockedWord(NormalizeWord(lettersOnly)) {
+		return "", ErrWordNotAllowed
+	}
+	return cleaned, nil
+}
+
+func isBlockedWord(normalized string) bool {
+	for _, part := range strings.FieldsFunc(normalized, func(r rune) bool { return r == ' ' || r == '-' }) {
+		if blockedExceptions[part] {
+			continue
+		}
+		for _, blocked := range blockedWords {
+			// Kısa kökler (ör. "aq", "sik") sadece tam kelime olarak eşleşir, aksi halde masum kelimeler takılır
+			if part == blocked || (utf8.RuneCountInString(blocked) > 3 && strings.Contains(part, blocked)) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -20,4 +20,7 @@ type Client struct {
 	Conn           *websocket.Conn
 	WriteLock      sync.Mutex
 	Done           chan struct{}
+	IsSpectator    bool     // Sadece izleyebilir; çizim/tahmin gönderemez
+	IsMember       bool     // room_players tablosunda kaydı var mı (oyun bitince oyuncuya dönüşebilir)
+	Role           RoomRole // Odadaki rolü (host, co_host, player, spectator); yetki kontrolleri buna göre yapılır
 }
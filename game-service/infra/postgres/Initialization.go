@@ -25,15 +25,26 @@ const (
 			mode_name VARCHAR(50) UNIQUE NOT NULL,
 			description TEXT,
 			min_players INT DEFAULT 2,
-			max_players INT DEFAULT 10
+			max_players INT DEFAULT 10,
+			default_rounds INT NOT NULL DEFAULT 2, -- Ayarı kaydedilmemiş odalar için tur sayısı
+			default_round_duration INT NOT NULL DEFAULT 60 -- Saniye cinsinden
 		);`
 
+	// Oyun motorları mod ID'leriyle eşleştiği için (bkz. NewGameHub) modlar sabit ID'lerle eklenir.
+	// ON CONFLICT her açılışta sequence değeri harcadığından SERIAL'a bırakılan ID'ler kayardı.
 	insertGameModes = `
-		INSERT INTO game_modes (mode_name, description, min_players, max_players) VALUES
-		('Çizim ve Tahmin', 'Her oyuncu bir kelime yazar, diğerleri bu kelimeleri çizmeye çalışır', 2, 8),
-		('Ortak Alan', 'Tüm oyuncular aynı canvas üzerinde birlikte çizim yapar', 2, 12),
-		('Serbest Çizim', 'Herkes istediği gibi çizim yapabilir, yarışma yok', 1, 20)
-		ON CONFLICT (mode_name) DO NOTHING;`
+		INSERT INTO game_modes (id, mode_name, description, min_players, max_players, default_rounds, default_round_duration) VALUES
+		(1, 'Çizim ve Tahmin', 'Her oyuncu bir kelime yazar, diğerleri bu kelimeleri çizmeye çalışır', 2, 8, 2, 60),
+		(2, 'Ortak Alan', 'Tüm oyuncular aynı canvas üzerinde birlikte çizim yapar', 2, 12, 1, 120),
+		(3, 'Serbest Çizim', 'Herkes istediği gibi çizim yapabilir, yarışma yok', 1, 20, 1, 120),
+		(4, 'Takım Modu', 'Oyuncular takımlara ayrılır; takım arkadaşının çizimini bilen takım puan alır, rakipler süre dolunca çalabilir', 4, 12, 4, 80),
+		(5, 'Kırık Telefon', 'Herkes bir cümle yazar; cümleler oyuncudan oyuncuya geçerek sırayla çizilir ve anlatılır, sonunda zincirler açılır', 3, 12, 1, 60),
+		(6, 'Çizim Yarışması', 'Herkes aynı kelimeyi kimse görmeden çizer; çizimler galeride oylanır ve puanlar oylardan gelir', 3, 12, 3, 90)
+		ON CONFLICT DO NOTHING;`
+
+	// Sabit ID'lerle eklenen modlardan sonra sequence'i ileri alır; sonradan eklenen modlar çakışmaz.
+	syncGameModesSequence = `
+		SELECT setval(pg_get_serial_sequence('game_modes', 'id'), (SELECT MAX(id) FROM game_modes));`
 
 	createRoomsTable = `
 		CREATE TABLE IF NOT EXISTS rooms (
@@ -46,6 +57,8 @@ const (
 			game_mode_id INT REFERENCES game_modes(id) NOT NULL,
 			is_private BOOLEAN DEFAULT FALSE,
 			room_code VARCHAR(10), -- Özel odalar için kod
+			language_code VARCHAR(10) NOT NULL DEFAULT 'tr',
+			settings JSONB, -- Oyun ayarları; NULL ise modun varsayılanları kullanılır
 			created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
 			started_at TIMESTAMP WITH TIME ZONE,
 			finished_at TIMESTAMP WITH TIME ZONE
@@ -60,9 +73,25 @@ const (
 			is_banned BOOLEAN DEFAULT FALSE,
 			score INT DEFAULT 0,
 			is_online BOOLEAN DEFAULT TRUE,
+			banned_at TIMESTAMP WITH TIME ZONE,
+			role VARCHAR(16) NOT NULL DEFAULT 'player', -- 'player' veya 'co_host' (host rooms.creator_id'dir)
+			last_seen_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP, -- Son bağlanma/ayrılma zamanı
 			UNIQUE(room_id, user_id)
 		);`
 
+	createRoomInvitesTable = `
+		CREATE TABLE IF NOT EXISTS room_invites (
+			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+			room_id UUID REFERENCES rooms(id) ON DELETE CASCADE NOT NULL,
+			token VARCHAR(64) UNIQUE NOT NULL,
+			created_by UUID REFERENCES users(id) NOT NULL,
+			expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+			max_uses INT NOT NULL DEFAULT 1,
+			uses INT NOT NULL DEFAULT 0,
+			revoked_at TIMESTAMP WITH TIME ZONE,
+			created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+		);`
+
 	createWordsTable = `
 		CREATE TABLE IF NOT EXISTS words (
 			id SERIAL PRIMARY KEY,
@@ -107,6 +136,59 @@ const (
 			created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
 		);`
 
+	// Biten oyunların sonuç ekranı özeti (sıralama, tur istatistikleri, kelimeler)
+	createGameSummariesTable = `
+		CREATE TABLE IF NOT EXISTS game_summaries (
+			id UUID PRIMARY KEY,
+			room_id UUID REFERENCES rooms(id) ON DELETE CASCADE NOT NULL,
+			game_mode_id INT REFERENCES game_modes(id),
+			completed BOOLEAN NOT NULL DEFAULT TRUE,
+			started_at TIMESTAMP WITH TIME ZONE,
+			ended_at TIMESTAMP WITH TIME ZONE NOT NULL,
+			summary JSONB NOT NULL
+		);`
+
+	// Var olan veritabanlarına sonradan eklenen kolonlar
+	migrateRoomPlayersBannedAt = `
+		ALTER TABLE room_players ADD COLUMN IF NOT EXISTS banned_at TIMESTAMP WITH TIME ZONE;`
+
+	migrateRoomPlayersLastSeenAt = `
+		ALTER TABLE room_players ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;`
+
+	migrateRoomsLanguageCode = `
+		ALTER TABLE rooms ADD COLUMN IF NOT EXISTS language_code VARCHAR(10) NOT NULL DEFAULT 'tr';`
+
+	migrateRoomsSettings = `
+		ALTER TABLE rooms ADD COLUMN IF NOT EXISTS settings JSONB;`
+
+	// Varsayılan tur ayarları kolonları ilk kez eklenirken eski modların değerleri de doldurulur.
+	migrateGameModesDefaults = `
+		DO $$
+		BEGIN
+			IF NOT EXISTS (
+				SELECT 1 FROM information_schema.columns
+				WHERE table_name = 'game_modes' AND column_name = 'default_rounds'
+			) THEN
+				ALTER TABLE game_modes ADD COLUMN default_rounds INT NOT NULL DEFAULT 2;
+				ALTER TABLE game_modes ADD COLUMN default_round_duration INT NOT NULL DEFAULT 60;
+				UPDATE game_modes SET default_rounds = 1, default_round_duration = 120 WHERE mode_name <> 'Çizim ve Tahmin';
+			END IF;
+		END $$;`
+
+	// Eski (istemcinin gönderdiği) kodlardaki çakışmalar temizlenir, ardından kodlar benzersiz yapılır.
+	migrateRoomsRoomCodeUnique = `
+		UPDATE rooms SET room_code = NULL
+		WHERE id IN (
+			SELECT id FROM (
+				SELECT id, ROW_NUMBER() OVER (PARTITION BY room_code ORDER BY created_at DESC) AS rn
+				FROM rooms WHERE room_code IS NOT NULL
+			) duplicates WHERE rn > 1
+		);
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_rooms_room_code ON rooms(room_code) WHERE room_code IS NOT NULL;`
+
+	migrateRoomPlayersRole = `
+		ALTER TABLE room_players ADD COLUMN IF NOT EXISTS role VARCHAR(16) NOT NULL DEFAULT 'player';`
+
 	// Performans için indeksler
 	createIndexes = `
 		CREATE UNIQUE INDEX IF NOT EXISTS idx_users_username ON users(username);
@@ -115,12 +197,25 @@ const (
 		CREATE INDEX IF NOT EXISTS idx_words_difficulty ON words(difficulty);
 		CREATE INDEX IF NOT EXISTS idx_rooms_status ON rooms(status);
 		CREATE INDEX IF NOT EXISTS idx_rooms_game_mode ON rooms(game_mode_id);
+		CREATE INDEX IF NOT EXISTS idx_rooms_waiting_newest ON rooms(created_at DESC, id DESC) WHERE status = 'waiting';
+		CREATE INDEX IF NOT EXISTS idx_rooms_waiting_fullest ON rooms(current_players DESC, created_at DESC, id DESC) WHERE status = 'waiting';
+		CREATE INDEX IF NOT EXISTS idx_rooms_waiting_free_seats ON rooms((max_players - current_players), created_at, id) WHERE status = 'waiting';
+		CREATE INDEX IF NOT EXISTS idx_rooms_waiting_mode_language ON rooms(game_mode_id, language_code) WHERE status = 'waiting';
 		CREATE INDEX IF NOT EXISTS idx_room_players_room_id ON room_players(room_id);
 		CREATE INDEX IF NOT EXISTS idx_room_players_user_id ON room_players(user_id);
+		CREATE INDEX IF NOT EXISTS idx_room_players_last_seen_at ON room_players(last_seen_at) WHERE is_banned = FALSE;
+		CREATE INDEX IF NOT EXISTS idx_room_invites_room_id ON room_invites(room_id);
 		CREATE INDEX IF NOT EXISTS idx_game_sessions_room_id ON game_sessions(room_id);
 		CREATE INDEX IF NOT EXISTS idx_game_actions_session_id ON game_actions(session_id);
 		CREATE INDEX IF NOT EXISTS idx_game_actions_type ON game_actions(action_type);
-		CREATE INDEX IF NOT EXISTS idx_drawing_data_session_id ON drawing_data(session_id);`
+		CREATE INDEX IF NOT EXISTS idx_drawing_data_session_id ON drawing_data(session_id);
+		CREATE INDEX IF NOT EXISTS idx_game_summaries_room_ended ON game_summaries(room_id, ended_at DESC);`
+
+	// Oda adında ILIKE araması için trigram indeksi. pg_trgm eklentisi için yetki gerekebileceğinden
+	// başarısız olursa arama indekssiz çalışmaya devam eder.
+	createSearchIndexes = `
+		CREATE EXTENSION IF NOT EXISTS pg_trgm;
+		CREATE INDEX IF NOT EXISTS idx_rooms_room_name_trgm ON rooms USING GIN (room_name gin_trgm_ops);`
 
 	// Bazı örnek kelimeler ekle
 	insertSampleWords = `
@@ -150,10 +245,12 @@ func initDB(db *sql.DB) error {
 		{"game_modes", createGameModesTable},
 		{"rooms", createRoomsTable},
 		{"room_players", createRoomPlayersTable},
+		{"room_invites", createRoomInvitesTable},
 		{"words", createWordsTable},
 		{"game_sessions", createGameSessionsTable},
 		{"game_actions", createGameActionsTable},
 		{"drawing_data", createDrawingDataTable},
+		{"game_summaries", createGameSummariesTable},
 	}
 
 	for _, table := range tables {
@@ -163,10 +260,33 @@ func initDB(db *sql.DB) error {
 		log.Printf("Table '%s' created successfully", table.name)
 	}
 
+	// 1.1 Kolon eklemeleri (tablo daha önce oluşturulmuşsa)
+	migrations := []struct {
+		name  string
+		query string
+	}{
+		{"room_players.banned_at", migrateRoomPlayersBannedAt},
+		{"room_players.role", migrateRoomPlayersRole},
+		{"room_players.last_seen_at", migrateRoomPlayersLastSeenAt},
+		{"rooms.language_code", migrateRoomsLanguageCode},
+		{"rooms.room_code unique", migrateRoomsRoomCodeUnique},
+		{"rooms.settings", migrateRoomsSettings},
+		{"game_modes defaults", migrateGameModesDefaults},
+	}
+
+	for _, migration := range migrations {
+		if _, err := db.Exec(migration.query); err != nil {
+			return fmt.Errorf("failed to apply migration '%s': %w", migration.name, err)
+		}
+	}
+
 	// 2. Başlangıç verilerini ekle
 	if _, err := db.Exec(insertGameModes); err != nil {
 		return fmt.Errorf("failed to insert game modes: %w", err)
 	}
+	if _, err := db.Exec(syncGameModesSequence); err != nil {
+		return fmt.Errorf("failed to sync game modes sequence: %w", err)
+	}
 
 	if _, err := db.Exec(insertSampleWords); err != nil {
 		return fmt.Errorf("failed to insert sample words: %w", err)
@@ -176,6 +296,9 @@ func initDB(db *sql.DB) error {
 	if _, err := db.Exec(createIndexes); err != nil {
 		return fmt.Errorf("failed to create indexes: %w", err)
 	}
+	if _, err := db.Exec(createSearchIndexes); err != nil {
+		log.Printf("⚠️ Room name search index could not be created, search will run without it: %v", err)
+	}
 
 	log.Println("Database initialized successfully with all tables and indexes")
 	return nil
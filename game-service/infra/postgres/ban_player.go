@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// BanPlayer, oyuncuyu odadan çıkarır ve tekrar katılmasını engeller.
+// Ban kaydı room_players tablosunda is_banned = TRUE olarak tutulur.
+func (r *Repository) BanPlayer(ctx context.Context, roomID, hostID, targetID uuid.UUID) error {
+	if hostID == targetID {
+		return fmt.Errorf("%w: host cannot ban themselves", domain.ErrInvalidInput)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	actorRole, err := lockRoomWithPermission(ctx, tx, roomID, hostID, domain.PermBanPlayer)
+	if err != nil {
+		return err
+	}
+	if err := checkOutranks(ctx, tx, roomID, targetID, actorRole, domain.PermBanPlayer); err != nil {
+		return err
+	}
+
+	// Oyuncu şu an aktif üye mi? (Üyeyse oyuncu sayısı düşürülmeli)
+	var wasMember bool
+	err = tx.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM room_players WHERE room_id = $1 AND user_id = $2 AND is_banned = FALSE)`,
+		roomID, targetID,
+	).Scan(&wasMember)
+	if err != nil {
+		return fmt.Errorf("failed to check room membership: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO room_players (room_id, user_id, is_banned, is_online, banned_at)
+		 VALUES ($1, $2, TRUE, FALSE, CURRENT_TIMESTAMP)
+		 ON CONFLICT (room_id, user_id)
+		 DO UPDATE SET is_banned = TRUE, is_online = FALSE, banned_at = CURRENT_TIMESTAMP, role = 'player'`,
+		roomID, targetID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to ban player: %w", err)
+	}
+
+	if wasMember {
+		_, err = tx.ExecContext(ctx,
+			`UPDATE rooms SET current_players = GREATEST(current_players - 1, 0) WHERE id = $1`,
+			roomID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to decrement player count: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("User %s banned from room %s by %s", targetID, roomID, hostID)
+	return nil
+}
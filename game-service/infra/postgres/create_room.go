@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"game-service/domain"
 	"log"
@@ -10,36 +12,63 @@ import (
 	"github.com/google/uuid"
 )
 
-func (r *Repository) CreateRoom(ctx context.Context, roomName string, creatorID uuid.UUID, maxPlayers int, gameModeID int, isPrivate bool, roomCode string) (uuid.UUID, error) {
-	// 1. Gelen veriyi doğrula
-	// Not: Bu kontrol, daha çok iş mantığı katmanında (service layer) yapılmalıdır.
-	// Ancak burada da gösterilebilir.
-	// `game_modes` tablosundan min/max değerlerini çekerek daha dinamik bir kontrol yapabilirsiniz.
-	if maxPlayers < 2 || maxPlayers > 12 {
-		return uuid.Nil, fmt.Errorf("%w: invalid number of players", domain.ErrInvalidInput)
-	}
-
+// CreateRoom, yeni oda oluşturur. Özel odalar için sunucu tarafında benzersiz bir kod üretilir
+// ve odanın ID'si ile birlikte döndürülür.
+func (r *Repository) CreateRoom(ctx context.Context, roomName string, creatorID uuid.UUID, maxPlayers int, gameModeID int, isPrivate bool) (uuid.UUID, string, error) {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
+		return uuid.Nil, "", fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 
-	// 2. Yeni odayı ekle ve ID'yi al
+	// 1. Oyuncu sayısını modun katalogdaki sınırlarına göre doğrula; verilmemişse modun üst sınırı kullanılır.
+	mode, err := gameModeByID(ctx, tx, gameModeID)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+	if maxPlayers == 0 {
+		maxPlayers = mode.MaxPlayers
+	}
+	if maxPlayers < mode.MinPlayers || maxPlayers > mode.MaxPlayers {
+		return uuid.Nil, "", fmt.Errorf("%w: max_players must be between %d and %d for %s",
+			domain.ErrInvalidInput, mode.MinPlayers, mode.MaxPlayers, mode.Name)
+	}
+
+	// 2. Yeni odayı ekle ve ID'yi al. Kod çakışırsa (ON CONFLICT) yeni kodla tekrar denenir.
 	roomQuery := `
 		INSERT INTO rooms (room_name, creator_id, max_players, current_players, status, game_mode_id, is_private, room_code)
 		VALUES ($1, $2, $3, 1, 'waiting', $4, $5, $6)
+		ON CONFLICT (room_code) WHERE room_code IS NOT NULL DO NOTHING
 		RETURNING id
 	`
 	var roomID uuid.UUID
-	err = tx.QueryRowContext(ctx, roomQuery, roomName, creatorID, maxPlayers, gameModeID, isPrivate, roomCode).Scan(&roomID)
-	if err != nil {
+	var roomCode sql.NullString
+	for attempt := 0; attempt < roomCodeMaxRetries; attempt++ {
+		if isPrivate {
+			code, err := generateRoomCode()
+			if err != nil {
+				return uuid.Nil, "", err
+			}
+			roomCode = sql.NullString{String: code, Valid: true}
+		}
+
+		err = tx.QueryRowContext(ctx, roomQuery, roomName, creatorID, maxPlayers, gameModeID, isPrivate, roomCode).Scan(&roomID)
+		if err == nil {
+			break
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			// Kod çakıştı, yenisini dene
+			continue
+		}
 		// PostgreSQL hata kodlarını veya mesajını kontrol et
 		if strings.Contains(err.Error(), "unique constraint") {
-			return uuid.Nil, fmt.Errorf("%w: room with this name or code already exists", domain.ErrConflict)
+			return uuid.Nil, "", fmt.Errorf("%w: room with this name already exists", domain.ErrConflict)
 		}
 		// Diğer beklenmedik veritabanı hataları
-		return uuid.Nil, fmt.Errorf("failed to create room: %w", err)
+		return uuid.Nil, "", fmt.Errorf("failed to create room: %w", err)
+	}
+	if roomID == uuid.Nil {
+		return uuid.Nil, "", fmt.Errorf("%w: could not generate a unique room code", domain.ErrConflict)
 	}
 
 	// 3. Oluşturan kullanıcıyı odaya ekle
@@ -50,13 +79,13 @@ func (r *Repository) CreateRoom(ctx context.Context, roomName string, creatorID
 	_, err = tx.ExecContext(ctx, playerQuery, roomID, creatorID)
 	if err != nil {
 		// Bu hatayı da kontrol edebilirsiniz, ancak normalde bu işlem başarılı olur.
-		return uuid.Nil, fmt.Errorf("failed to add creator to room: %w", err)
+		return uuid.Nil, "", fmt.Errorf("failed to add creator to room: %w", err)
 	}
 
 	if err = tx.Commit(); err != nil {
-		return uuid.Nil, fmt.Errorf("failed to commit transaction: %w", err)
+		return uuid.Nil, "", fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
 	log.Printf("Room '%s' created successfully with ID %s", roomName, roomID)
-	return roomID, nil
+	return roomID, roomCode.String, nil
 }
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"game-service/domain"
+)
+
+const gameModeColumns = `id, mode_name, description, min_players, max_players, default_rounds, default_round_duration`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanGameMode(row rowScanner) (domain.GameMode, error) {
+	var (
+		id          int
+		name        string
+		description sql.NullString
+		limits      domain.GameModeLimits
+	)
+	if err := row.Scan(&id, &name, &description, &limits.MinPlayers, &limits.MaxPlayers,
+		&limits.DefaultRounds, &limits.DefaultRoundDuration); err != nil {
+		return domain.GameMode{}, err
+	}
+	return domain.NewGameMode(id, name, description.String, limits), nil
+}
+
+// gameModeByID, oyun modunu verilen bağlantı/işlem üzerinden okur. Mod yoksa ErrInvalidInput döner.
+func gameModeByID(ctx context.Context, q queryRower, gameModeID int) (domain.GameMode, error) {
+	mode, err := scanGameMode(q.QueryRowContext(ctx,
+		`SELECT `+gameModeColumns+` FROM game_modes WHERE id = $1`, gameModeID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.GameMode{}, fmt.Errorf("%w: game mode ID %d does not exist", domain.ErrInvalidInput, gameModeID)
+		}
+		return domain.GameMode{}, fmt.Errorf("failed to query game mode: %w", err)
+	}
+	return mode, nil
+}
+
+// GetGameModes, oyun modu kataloğunu döner.
+func (r *Repository) GetGameModes(ctx context.Context) ([]domain.GameMode, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+gameModeColumns+` FROM game_modes ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query game modes: %w", err)
+	}
+	defer rows.Close()
+
+	modes := []domain.GameMode{}
+	for rows.Next() {
+		mode, err := scanGameMode(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan game mode: %w", err)
+		}
+		modes = append(modes, mode)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate game modes: %w", err)
+	}
+	return modes, nil
+}
+
+// GetGameMode, tek bir oyun modunun katalog kaydını döner.
+func (r *Repository) GetGameMode(ctx context.Context, gameModeID int) (domain.GameMode, error) {
+	return gameModeByID(ctx, r.db, gameModeID)
+}
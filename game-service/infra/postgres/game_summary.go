@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"game-service/domain"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// SaveGameSummary, biten oyunun özetini saklar. Aynı özet tekrar yazılırsa yok sayılır.
+func (r *Repository) SaveGameSummary(ctx context.Context, summary domain.GameSummary) error {
+	raw, err := json.Marshal(summary)
+	if err != nil {
+		return fmt.Errorf("failed to encode game summary: %w", err)
+	}
+
+	var (
+		modeID    sql.NullInt64
+		startedAt sql.NullTime
+	)
+	if summary.GameModeID > 0 {
+		modeID = sql.NullInt64{Int64: int64(summary.GameModeID), Valid: true}
+	}
+	if !summary.StartedAt.IsZero() {
+		startedAt = sql.NullTime{Time: summary.StartedAt, Valid: true}
+	}
+
+	if _, err := r.db.ExecContext(ctx,
+		`INSERT INTO game_summaries (id, room_id, game_mode_id, completed, started_at, ended_at, summary)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 ON CONFLICT (id) DO NOTHING`,
+		summary.ID, summary.RoomID, modeID, summary.Completed, startedAt, summary.EndedAt, raw,
+	); err != nil {
+		return fmt.Errorf("failed to insert game summary: %w", err)
+	}
+
+	log.Printf("Game summary %s saved for room %s", summary.ID, summary.RoomID)
+	return nil
+}
+
+// GetGameSummaries, odada oynanan son oyunların özetlerini yeniden eskiye doğru döner.
+func (r *Repository) GetGameSummaries(ctx context.Context, roomID uuid.UUID, limit int) ([]domain.GameSummary, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT summary FROM game_summaries WHERE room_id = $1 ORDER BY ended_at DESC LIMIT $2`,
+		roomID, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query game summaries: %w", err)
+	}
+	defer rows.Close()
+
+	summaries := []domain.GameSummary{}
+	for rows.Next() {
+		var raw []byte
+		if err := rows.Scan(&raw); err != nil {
+			return nil, fmt.Errorf("failed to scan game summary: %w", err)
+		}
+		var summary domain.GameSummary
+		if err := json.Unmarshal(raw, &summary); err != nil {
+			return nil, fmt.Errorf("failed to decode game summary: %w", err)
+		}
+		summaries = append(summaries, summary)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate game summaries: %w", err)
+	}
+	return summaries, nil
+}
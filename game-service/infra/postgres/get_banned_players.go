@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+
+	"github.com/google/uuid"
+)
+
+const getBannedPlayersQuery = `
+	SELECT rp.user_id, u.username, COALESCE(rp.banned_at, rp.joined_at)
+	FROM room_players rp
+	INNER JOIN users u ON u.id = rp.user_id
+	WHERE rp.room_id = $1 AND rp.is_banned = TRUE
+	ORDER BY rp.banned_at DESC NULLS LAST;`
+
+// GetBannedPlayers, odadan banlanan oyuncuları döndürür. Sadece host ve co-host listeleyebilir.
+func (r *Repository) GetBannedPlayers(ctx context.Context, roomID, hostID uuid.UUID) ([]domain.BannedPlayer, error) {
+	role, err := roomRole(ctx, r.db, roomID, hostID, false)
+	if err != nil {
+		return nil, err
+	}
+	if !role.Can(domain.PermBanPlayer) {
+		return nil, domain.NewPermissionError(role, domain.PermBanPlayer)
+	}
+
+	rows, err := r.db.QueryContext(ctx, getBannedPlayersQuery, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query banned players: %w", err)
+	}
+	defer rows.Close()
+
+	var players []domain.BannedPlayer
+	for rows.Next() {
+		var player domain.BannedPlayer
+		if err := rows.Scan(&player.UserID, &player.Username, &player.BannedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan banned player: %w", err)
+		}
+		players = append(players, player)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return players, nil
+}
+
+// IsBannedFromRoom, kullanıcının odadan banlı olup olmadığını döndürür.
+func (r *Repository) IsBannedFromRoom(ctx context.Context, roomID, userID uuid.UUID) (bool, error) {
+	var isBanned bool
+	err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM room_players WHERE room_id = $1 AND user_id = $2 AND is_banned = TRUE)`,
+		roomID, userID,
+	).Scan(&isBanned)
+	if err != nil {
+		return false, fmt.Errorf("failed to check ban status: %w", err)
+	}
+	return isBanned, nil
+}
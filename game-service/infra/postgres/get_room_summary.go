@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"game-service/domain"
+
+	"github.com/google/uuid"
+)
+
+// GetRoomSummary, lobide gösterilen oda bilgilerini döner. Oda kodu dahil edilmez.
+func (r *Repository) GetRoomSummary(ctx context.Context, roomID uuid.UUID) (domain.Room, error) {
+	var room domain.Room
+	err := r.db.QueryRowContext(ctx,
+		`SELECT r.id, r.room_name, r.creator_id, r.max_players, r.current_players, r.status,
+		        r.game_mode_id, r.is_private, r.language_code, r.created_at, gm.mode_name
+		 FROM rooms r
+		 INNER JOIN game_modes gm ON r.game_mode_id = gm.id
+		 WHERE r.id = $1`,
+		roomID,
+	).Scan(&room.ID, &room.RoomName, &room.CreatorID, &room.MaxPlayers, &room.CurrentPlayers, &room.Status,
+		&room.GameModeID, &room.IsPrivate, &room.LanguageCode, &room.CreatedAt, &room.ModeName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Room{}, fmt.Errorf("%w: room not found", domain.ErrNotFound)
+		}
+		return domain.Room{}, fmt.Errorf("failed to query room summary: %w", err)
+	}
+	return room, nil
+}
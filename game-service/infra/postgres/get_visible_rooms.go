@@ -2,63 +2,176 @@ package postgres
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"game-service/domain"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-const getVisibleRoomsQuery = `
+const (
+	defaultRoomPageSize = 20
+	maxRoomPageSize     = 50
+)
+
+const getVisibleRoomsBaseQuery = `
     SELECT
         r.id, r.room_name, r.creator_id, r.max_players, r.current_players, r.status,
-        r.game_mode_id, r.is_private, COALESCE(r.room_code, '') AS room_code, gm.mode_name,
+        r.game_mode_id, r.is_private, COALESCE(r.room_code, '') AS room_code, r.language_code, r.created_at, gm.mode_name,
 		CASE WHEN rp.user_id IS NOT NULL THEN TRUE ELSE FALSE END AS is_user_in_room 
     FROM
         rooms r
     INNER JOIN
         game_modes gm ON r.game_mode_id = gm.id
 	LEFT JOIN 
-    	room_players rp ON r.id = rp.room_id AND rp.user_id = $1 -- $1
+    	room_players rp ON r.id = rp.room_id AND rp.user_id = $1 AND rp.is_banned = FALSE -- $1
     WHERE
         -- Durumu 'waiting' olan odaları çekiyoruz, böylece sadece oynanabilir odalar listelenir.
         r.status = 'waiting'
-        AND (
-            -- KOŞUL 1: Gizli olmayan odalar (is_private = FALSE)
-            r.is_private = FALSE
-            OR
-            -- KOŞUL 2: Kullanıcının üyesi olduğu gizli odalar (is_private = TRUE)
-            (
-                r.is_private = TRUE
-                AND EXISTS (
-                    SELECT 1 FROM room_players rp
-                    WHERE rp.room_id = r.id AND rp.user_id = $1
-                )
-            )
-        )
-    ORDER BY
-        r.created_at DESC;`
-
-// GetVisibleRooms, kullanıcının görebileceği (gizli olmayan veya üyesi olduğu gizli) tüm odaları döndürür.
-func (r *Repository) GetVisibleRooms(ctx context.Context, userID uuid.UUID) ([]domain.Room, error) {
-	rows, err := r.db.QueryContext(ctx, getVisibleRoomsQuery, userID)
+        -- Gizli olmayan odalar veya kullanıcının üyesi olduğu gizli odalar
+        AND (r.is_private = FALSE OR rp.user_id IS NOT NULL)`
+
+// roomSortSpec, her sıralama için sıralama anahtarını ve keyset karşılaştırmasını tanımlar.
+// Anahtar sütunların hepsi aynı yönde sıralandığı için satır karşılaştırması (tuple) kullanılabilir.
+type roomSortSpec struct {
+	key       string // Birincil sıralama ifadesi (tamsayı); boşsa sadece created_at, id kullanılır
+	direction string
+	operator  string // Sonraki sayfa için keyset operatörü
+}
+
+var roomSortSpecs = map[string]roomSortSpec{
+	domain.RoomSortNewest:       {direction: "DESC", operator: "<"},
+	domain.RoomSortFullest:      {key: "r.current_players", direction: "DESC", operator: "<"},
+	domain.RoomSortAboutToStart: {key: "(r.max_players - r.current_players)", direction: "ASC", operator: ">"},
+}
+
+// roomCursor, sayfalama için son satırın sıralama değerlerini taşır. İstemciye opak bir string olarak verilir.
+type roomCursor struct {
+	Sort      string    `json:"s"`
+	Key       int       `json:"k"`
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"i"`
+}
+
+func encodeRoomCursor(c roomCursor) string {
+	payload, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func decodeRoomCursor(s string) (roomCursor, error) {
+	var c roomCursor
+	payload, err := base64.RawURLEncoding.DecodeString(s)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query visible rooms: %w", err)
+		return c, fmt.Errorf("%w: invalid cursor", domain.ErrInvalidInput)
+	}
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return c, fmt.Errorf("%w: invalid cursor", domain.ErrInvalidInput)
+	}
+	return c, nil
+}
+
+// escapeLike, arama metnindeki LIKE joker karakterlerini etkisizleştirir.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// roomSortKey, cursor için satırın birincil sıralama değerini hesaplar.
+func roomSortKey(sort string, room domain.Room) int {
+	switch sort {
+	case domain.RoomSortFullest:
+		return room.CurrentPlayers
+	case domain.RoomSortAboutToStart:
+		return room.MaxPlayers - room.CurrentPlayers
+	default:
+		return 0
+	}
+}
+
+// GetVisibleRooms, kullanıcının görebileceği (gizli olmayan veya üyesi olduğu gizli) odaları
+// filtreleyip sıralayarak sayfa sayfa döndürür. Son sayfada nextCursor boştur.
+func (r *Repository) GetVisibleRooms(ctx context.Context, userID uuid.UUID, filter domain.RoomListFilter) ([]domain.Room, string, error) {
+	if filter.Sort == "" {
+		filter.Sort = domain.RoomSortNewest
+	}
+	spec, ok := roomSortSpecs[filter.Sort]
+	if !ok {
+		return nil, "", fmt.Errorf("%w: unknown sort option %q", domain.ErrInvalidInput, filter.Sort)
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultRoomPageSize
+	}
+	if filter.Limit > maxRoomPageSize {
+		filter.Limit = maxRoomPageSize
+	}
+
+	var sb strings.Builder
+	sb.WriteString(getVisibleRoomsBaseQuery)
+	args := []interface{}{userID}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.GameModeID > 0 {
+		sb.WriteString("\n        AND r.game_mode_id = " + arg(filter.GameModeID))
+	}
+	if filter.LanguageCode != "" {
+		sb.WriteString("\n        AND r.language_code = " + arg(filter.LanguageCode))
+	}
+	if filter.HasFreeSeats {
+		sb.WriteString("\n        AND r.current_players < r.max_players")
+	}
+	if filter.OnlyPrivate {
+		sb.WriteString("\n        AND r.is_private = TRUE")
+	}
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		sb.WriteString("\n        AND r.room_name ILIKE " + arg("%"+escapeLike(search)+"%"))
+	}
+	if filter.Cursor != "" {
+		cursor, err := decodeRoomCursor(filter.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		if cursor.Sort != filter.Sort {
+			return nil, "", fmt.Errorf("%w: cursor does not match sort option", domain.ErrInvalidInput)
+		}
+		if spec.key == "" {
+			fmt.Fprintf(&sb, "\n        AND (r.created_at, r.id) %s (%s, %s)",
+				spec.operator, arg(cursor.CreatedAt), arg(cursor.ID))
+		} else {
+			fmt.Fprintf(&sb, "\n        AND (%s, r.created_at, r.id) %s (%s, %s, %s)",
+				spec.key, spec.operator, arg(cursor.Key), arg(cursor.CreatedAt), arg(cursor.ID))
+		}
+	}
+
+	sb.WriteString("\n    ORDER BY ")
+	if spec.key != "" {
+		fmt.Fprintf(&sb, "%s %s, ", spec.key, spec.direction)
+	}
+	// Bir fazlası çekilir; fazladan satır varsa sonraki sayfa vardır
+	fmt.Fprintf(&sb, "r.created_at %[1]s, r.id %[1]s\n    LIMIT %[2]s;", spec.direction, arg(filter.Limit+1))
+
+	rows, err := r.db.QueryContext(ctx, sb.String(), args...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to query visible rooms: %w", err)
 	}
 	defer rows.Close()
 
-	var rooms []domain.Room
+	rooms := []domain.Room{}
 	for rows.Next() {
 		var room domain.Room
-		// Scan işlemine yeni alanı ekliyoruz.
 		err := rows.Scan(
 			&room.ID, &room.RoomName, &room.CreatorID, &room.MaxPlayers, &room.CurrentPlayers,
-			&room.Status, &room.GameModeID, &room.IsPrivate, &room.RoomCode, &room.ModeName,
-			&room.IsUserInRoom, // ⬅️ YENİ ALAN BURAYA EKLENDİ
+			&room.Status, &room.GameModeID, &room.IsPrivate, &room.RoomCode, &room.LanguageCode, &room.CreatedAt, &room.ModeName,
+			&room.IsUserInRoom,
 		)
 		if err != nil {
 			// Hata mesajını daha anlaşılır yapalım
-			return nil, fmt.Errorf("failed to scan room data from DB: %w", err)
+			return nil, "", fmt.Errorf("failed to scan room data from DB: %w", err)
 		}
 
 		// Gizli olmayan odaların RoomCode'unu temizleme (mevcut mantık)
@@ -70,9 +183,21 @@ func (r *Repository) GetVisibleRooms(ctx context.Context, userID uuid.UUID) ([]d
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows iteration error: %w", err)
+		return nil, "", fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	nextCursor := ""
+	if len(rooms) > filter.Limit {
+		rooms = rooms[:filter.Limit]
+		last := rooms[len(rooms)-1]
+		nextCursor = encodeRoomCursor(roomCursor{
+			Sort:      filter.Sort,
+			Key:       roomSortKey(filter.Sort, last),
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		})
 	}
 
-	log.Printf("Found %d visible rooms for user %s", len(rooms), userID)
-	return rooms, nil
+	log.Printf("Found %d visible rooms for user %s (sort=%s)", len(rooms), userID, filter.Sort)
+	return rooms, nextCursor, nil
 }
@@ -0,0 +1,24 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"game-service/domain"
+
+	"github.com/google/uuid"
+)
+
+// IsPublicRoom, odanın herkese açık olup olmadığını döndürür (seyirci kabulü için kullanılır).
+func (r *Repository) IsPublicRoom(ctx context.Context, roomID uuid.UUID) (bool, error) {
+	var isPrivate bool
+	err := r.db.QueryRowContext(ctx, `SELECT is_private FROM rooms WHERE id = $1`, roomID).Scan(&isPrivate)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, fmt.Errorf("%w: room not found", domain.ErrNotFound)
+		}
+		return false, fmt.Errorf("failed to query room visibility: %w", err)
+	}
+	return !isPrivate, nil
+}
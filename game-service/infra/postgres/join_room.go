@@ -11,20 +11,35 @@ import (
 	"github.com/google/uuid"
 )
 
-func (r *Repository) JoinRoom(ctx context.Context, roomID, userID uuid.UUID, roomCode string) error {
+func (r *Repository) JoinRoom(ctx context.Context, roomID, userID uuid.UUID, roomCode string, allowLateJoin bool) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 
+	if err := joinRoomTx(ctx, tx, roomID, userID, roomCode, allowLateJoin, false); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("User %s joined room %s successfully", userID, roomID)
+	return nil
+}
+
+// joinRoomTx, kullanıcıyı verilen transaction içinde odaya ekler. skipCodeCheck true ise
+// (ör. geçerli bir davet bağlantısıyla gelindiğinde) özel oda kodu kontrol edilmez.
+func joinRoomTx(ctx context.Context, tx *sql.Tx, roomID, userID uuid.UUID, roomCode string, allowLateJoin, skipCodeCheck bool) error {
 	// 1. Room bilgilerini çek
 	var maxPlayers, currentPlayers int
 	var status string
 	var isPrivate bool
 	var dbRoomCode sql.NullString
 
-	err = tx.QueryRowContext(ctx,
+	err := tx.QueryRowContext(ctx,
 		`SELECT max_players, current_players, status, is_private, room_code 
 		 FROM rooms WHERE id = $1 FOR UPDATE`,
 		roomID,
@@ -36,22 +51,35 @@ func (r *Repository) JoinRoom(ctx context.Context, roomID, userID uuid.UUID, roo
 		return fmt.Errorf("failed to query room: %w", err)
 	}
 
-	// 2. Oda durumu kontrol et
-	if status != "waiting" {
+	// 2. Oda durumu kontrol et (geç katılım açıksa devam eden oyunlara da katılınabilir)
+	if status != "waiting" && !(allowLateJoin && status == "playing") {
 		return fmt.Errorf("%w: room is not joinable", domain.ErrConflict)
 	}
 
-	// 3. Kapasite kontrolü
+	// 3. Ban kontrolü
+	var isBanned bool
+	err = tx.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM room_players WHERE room_id = $1 AND user_id = $2 AND is_banned = TRUE)`,
+		roomID, userID,
+	).Scan(&isBanned)
+	if err != nil {
+		return fmt.Errorf("failed to check ban status: %w", err)
+	}
+	if isBanned {
+		return fmt.Errorf("%w: user is banned from this room", domain.ErrForbidden)
+	}
+
+	// 3.1 Kapasite kontrolü
 	if currentPlayers >= maxPlayers {
 		return fmt.Errorf("%w: room is full", domain.ErrConflict)
 	}
 
 	// 4. Eğer private oda ise kod kontrol et
-	if isPrivate {
+	if isPrivate && !skipCodeCheck {
 		if !dbRoomCode.Valid || dbRoomCode.String == "" {
 			return fmt.Errorf("%w: private room has no code", domain.ErrInternal)
 		}
-		if roomCode != dbRoomCode.String {
+		if NormalizeRoomCode(roomCode) != dbRoomCode.String {
 			return fmt.Errorf("%w: invalid room code", domain.ErrForbidden)
 		}
 	}
@@ -77,11 +105,5 @@ func (r *Repository) JoinRoom(ctx context.Context, roomID, userID uuid.UUID, roo
 		return fmt.Errorf("failed to update room: %w", err)
 	}
 
-	// 7. Commit
-	if err = tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
-
-	log.Printf("User %s joined room %s successfully", userID, roomID)
 	return nil
 }
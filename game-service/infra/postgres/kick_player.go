@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// KickPlayer, oyuncuyu odadan çıkarır. Oyuncu daha sonra tekrar katılabilir.
+func (r *Repository) KickPlayer(ctx context.Context, roomID, hostID, targetID uuid.UUID) error {
+	if hostID == targetID {
+		return fmt.Errorf("%w: host cannot kick themselves", domain.ErrInvalidInput)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	actorRole, err := lockRoomWithPermission(ctx, tx, roomID, hostID, domain.PermKickPlayer)
+	if err != nil {
+		return err
+	}
+	if err := checkOutranks(ctx, tx, roomID, targetID, actorRole, domain.PermKickPlayer); err != nil {
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx,
+		`DELETE FROM room_players WHERE room_id = $1 AND user_id = $2 AND is_banned = FALSE`,
+		roomID, targetID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to remove player from room: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: user is not in the room", domain.ErrNotFound)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE rooms SET current_players = GREATEST(current_players - 1, 0) WHERE id = $1`,
+		roomID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to decrement player count: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("User %s kicked from room %s by %s", targetID, roomID, hostID)
+	return nil
+}
@@ -10,10 +10,12 @@ import (
 	"github.com/google/uuid"
 )
 
-func (r *Repository) LeaveRoom(ctx context.Context, roomID, userID uuid.UUID) error {
+// LeaveRoom, kullanıcıyı odadan çıkarır. Ayrılan kullanıcı host ise yeni host seçilir
+// ve ID'si döndürülür; host değişmediyse uuid.Nil döner.
+func (r *Repository) LeaveRoom(ctx context.Context, roomID, userID uuid.UUID) (uuid.UUID, error) {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback()
 
@@ -27,26 +29,28 @@ func (r *Repository) LeaveRoom(ctx context.Context, roomID, userID uuid.UUID) er
 	).Scan(&hostID, &currentPlayers)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return fmt.Errorf("%w: room not found", domain.ErrNotFound)
+			return uuid.Nil, fmt.Errorf("%w: room not found", domain.ErrNotFound)
 		}
-		return fmt.Errorf("failed to query room: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to query room: %w", err)
 	}
 
+	var newHostID uuid.UUID
+
 	// 2. Remove the user from the room.
 	res, err := tx.ExecContext(ctx,
-		`DELETE FROM room_players WHERE room_id = $1 AND user_id = $2`,
+		`DELETE FROM room_players WHERE room_id = $1 AND user_id = $2 AND is_banned = FALSE`,
 		roomID, userID,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to delete player from room: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to delete player from room: %w", err)
 	}
 
 	rowsAffected, err := res.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to get rows affected: %w", err)
 	}
 	if rowsAffected == 0 {
-		return fmt.Errorf("%w: user is not in the room", domain.ErrNotFound)
+		return uuid.Nil, fmt.Errorf("%w: user is not in the room", domain.ErrNotFound)
 	}
 
 	// 3. Check for specific conditions based on player count and role.
@@ -54,7 +58,7 @@ func (r *Repository) LeaveRoom(ctx context.Context, roomID, userID uuid.UUID) er
 		// If the user is the only player, delete the room.
 		_, err = tx.ExecContext(ctx, `DELETE FROM rooms WHERE id = $1`, roomID)
 		if err != nil {
-			return fmt.Errorf("failed to delete room: %w", err)
+			return uuid.Nil, fmt.Errorf("failed to delete room: %w", err)
 		}
 	} else {
 		// If there are other players, update the player count.
@@ -63,19 +67,19 @@ func (r *Repository) LeaveRoom(ctx context.Context, roomID, userID uuid.UUID) er
 			roomID,
 		)
 		if err != nil {
-			return fmt.Errorf("failed to decrement player count: %w", err)
+			return uuid.Nil, fmt.Errorf("failed to decrement player count: %w", err)
 		}
 
 		// If the leaving user was the host, assign a new one.
 		if hostID == userID {
-			var newHostID uuid.UUID
 			err = tx.QueryRowContext(ctx,
-				`SELECT user_id FROM room_players WHERE room_id = $1 LIMIT 1`,
+				`SELECT user_id FROM room_players WHERE room_id = $1 AND is_banned = FALSE
+				 ORDER BY (role = 'co_host') DESC, joined_at ASC LIMIT 1`,
 				roomID,
 			).Scan(&newHostID)
 			if err != nil {
 				// This case should ideally not be reached if currentPlayers > 1.
-				return fmt.Errorf("failed to find a new host: %w", err)
+				return uuid.Nil, fmt.Errorf("failed to find a new host: %w", err)
 			}
 
 			_, err = tx.ExecContext(ctx,
@@ -83,16 +87,16 @@ func (r *Repository) LeaveRoom(ctx context.Context, roomID, userID uuid.UUID) er
 				newHostID, roomID,
 			)
 			if err != nil {
-				return fmt.Errorf("failed to update new host: %w", err)
+				return uuid.Nil, fmt.Errorf("failed to update new host: %w", err)
 			}
 		}
 	}
 
 	// 4. Commit the transaction.
 	if err = tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+		return uuid.Nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
 	log.Printf("User %s left room %s successfully", userID, roomID)
-	return nil
+	return newHostID, nil
 }
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"game-service/domain"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+const defaultQuickPlayMode = 1
+
+// Hızlı oyun için uygun oda: herkese açık, bekleyen, boş koltuğu olan ve kullanıcının
+// zaten üyesi/banlısı olmadığı oda. Başlamaya en yakın (en kalabalık) oda tercih edilir.
+// SKIP LOCKED sayesinde eşzamanlı istekler aynı son koltuğa yarışmaz; FOR UPDATE
+// kilit alındıktan sonra WHERE koşulunu tekrar değerlendirdiği için oda taşmaz.
+const findQuickPlayRoomQuery = `
+	SELECT r.id
+	FROM rooms r
+	WHERE r.status = 'waiting'
+	  AND r.is_private = FALSE
+	  AND r.current_players < r.max_players
+	  AND ($1 = 0 OR r.game_mode_id = $1)
+	  AND ($2 = '' OR r.language_code = $2)
+	  AND NOT EXISTS (
+	      SELECT 1 FROM room_players rp WHERE rp.room_id = r.id AND rp.user_id = $3
+	  )
+	ORDER BY r.current_players DESC, r.created_at ASC
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED`
+
+// QuickPlay, kullanıcıyı filtrelere uyan en uygun odaya yerleştirir; uygun oda yoksa
+// yeni bir herkese açık oda oluşturur. Odanın ID'si ve yeni oluşturulup oluşturulmadığı döner.
+func (r *Repository) QuickPlay(ctx context.Context, userID uuid.UUID, gameModeID int, languageCode string) (uuid.UUID, bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// 1. Uygun odayı bul ve kilitle
+	var roomID uuid.UUID
+	err = tx.QueryRowContext(ctx, findQuickPlayRoomQuery, gameModeID, languageCode, userID).Scan(&roomID)
+	created := false
+
+	switch {
+	case err == nil:
+		// 2a. Mevcut odaya katıl
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO room_players (room_id, user_id) VALUES ($1, $2)`,
+			roomID, userID,
+		)
+		if err != nil {
+			return uuid.Nil, false, fmt.Errorf("failed to insert player: %w", err)
+		}
+		_, err = tx.ExecContext(ctx,
+			`UPDATE rooms SET current_players = current_players + 1 WHERE id = $1`,
+			roomID,
+		)
+		if err != nil {
+			return uuid.Nil, false, fmt.Errorf("failed to update room: %w", err)
+		}
+
+	case errors.Is(err, sql.ErrNoRows):
+		// 2b. Uygun oda yok, yeni oda oluştur
+		modeID := gameModeID
+		if modeID == 0 {
+			modeID = defaultQuickPlayMode
+		}
+		var mode domain.GameMode
+		mode, err = gameModeByID(ctx, tx, modeID)
+		if err != nil {
+			return uuid.Nil, false, err
+		}
+		maxPlayers := mode.MaxPlayers
+
+		err = tx.QueryRowContext(ctx,
+			`INSERT INTO rooms (room_name, creator_id, max_players, current_players, status, game_mode_id, is_private, language_code)
+			 VALUES ('Hızlı Oyun', $1, $2, 1, 'waiting', $3, FALSE, COALESCE(NULLIF($4, ''), 'tr'))
+			 RETURNING id`,
+			userID, maxPlayers, modeID, languageCode,
+		).Scan(&roomID)
+		if err != nil {
+			return uuid.Nil, false, fmt.Errorf("failed to create room: %w", err)
+		}
+		_, err = tx.ExecContext(ctx,
+			`INSERT INTO room_players (room_id, user_id) VALUES ($1, $2)`,
+			roomID, userID,
+		)
+		if err != nil {
+			return uuid.Nil, false, fmt.Errorf("failed to add creator to room: %w", err)
+		}
+		created = true
+
+	default:
+		return uuid.Nil, false, fmt.Errorf("failed to find quick play room: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return uuid.Nil, false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("Quick play: user %s placed in room %s (created: %v)", userID, roomID, created)
+	return roomID, created, nil
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"fmt"
+	"game-service/domain"
+	"log"
+	"math/big"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Karışabilecek karakterler (0/O, 1/I/L) çıkarıldı; kodlar sesli okunup yazılabilir.
+const (
+	roomCodeAlphabet   = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+	roomCodeLength     = 6
+	roomCodeMaxRetries = 5
+)
+
+// generateRoomCode, kriptografik olarak rastgele, kısa ve okunabilir bir oda kodu üretir.
+func generateRoomCode() (string, error) {
+	var sb strings.Builder
+	sb.Grow(roomCodeLength)
+	max := big.NewInt(int64(len(roomCodeAlphabet)))
+	for i := 0; i < roomCodeLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate room code: %w", err)
+		}
+		sb.WriteByte(roomCodeAlphabet[n.Int64()])
+	}
+	return sb.String(), nil
+}
+
+// NormalizeRoomCode, kullanıcının girdiği kodu karşılaştırma için normalize eder.
+func NormalizeRoomCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// GetRoomIDByCode, oda koduna göre odanın ID'sini döndürür.
+func (r *Repository) GetRoomIDByCode(ctx context.Context, roomCode string) (uuid.UUID, error) {
+	var roomID uuid.UUID
+	err := r.db.QueryRowContext(ctx,
+		`SELECT id FROM rooms WHERE room_code = $1`,
+		NormalizeRoomCode(roomCode),
+	).Scan(&roomID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, fmt.Errorf("%w: no room with this code", domain.ErrNotFound)
+		}
+		return uuid.Nil, fmt.Errorf("failed to query room by code: %w", err)
+	}
+	return roomID, nil
+}
+
+// RegenerateRoomCode, özel odanın kodunu yeniler; eski kod artık geçersizdir. Sadece host yapabilir.
+func (r *Repository) RegenerateRoomCode(ctx context.Context, roomID, actorID uuid.UUID) (string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := lockRoomWithPermission(ctx, tx, roomID, actorID, domain.PermRegenerateCode); err != nil {
+		return "", err
+	}
+
+	var isPrivate bool
+	if err := tx.QueryRowContext(ctx, `SELECT is_private FROM rooms WHERE id = $1`, roomID).Scan(&isPrivate); err != nil {
+		return "", fmt.Errorf("failed to query room: %w", err)
+	}
+	if !isPrivate {
+		return "", fmt.Errorf("%w: only private rooms have a room code", domain.ErrInvalidInput)
+	}
+
+	for attempt := 0; attempt < roomCodeMaxRetries; attempt++ {
+		code, err := generateRoomCode()
+		if err != nil {
+			return "", err
+		}
+
+		res, err := tx.ExecContext(ctx,
+			`UPDATE rooms SET room_code = $1
+			 WHERE id = $2 AND NOT EXISTS (SELECT 1 FROM rooms WHERE room_code = $1)`,
+			code, roomID,
+		)
+		if err != nil {
+			return "", fmt.Errorf("failed to update room code: %w", err)
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return "", fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			// Kod çakıştı, yenisini dene
+			continue
+		}
+
+		if err = tx.Commit(); err != nil {
+			return "", fmt.Errorf("failed to commit transaction: %w", err)
+		}
+		log.Printf("Room %s code regenerated by %s", roomID, actorID)
+		return code, nil
+	}
+
+	return "", fmt.Errorf("%w: could not generate a unique room code", domain.ErrConflict)
+}
@@ -0,0 +1,223 @@
+package postgres
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"game-service/domain"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	inviteTokenBytes  = 16
+	minInviteTTL      = time.Minute
+	maxInviteTTL      = 7 * 24 * time.Hour
+	maxInviteMaxUses  = 100
+	defaultInviteTTL  = 24 * time.Hour
+	defaultInviteUses = 1
+)
+
+// generateInviteToken, tahmin edilemez bir davet token'ı üretir (128 bit).
+func generateInviteToken() (string, error) {
+	b := make([]byte, inviteTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate invite token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// CreateRoomInvite, oda için süreli ve sınırlı kullanımlık bir davet oluşturur. Sadece host yapabilir.
+// ttl veya maxUses sıfırsa varsayılan değerler kullanılır.
+func (r *Repository) CreateRoomInvite(ctx context.Context, roomID, actorID uuid.UUID, ttl time.Duration, maxUses int) (domain.RoomInvite, error) {
+	if ttl == 0 {
+		ttl = defaultInviteTTL
+	}
+	if maxUses == 0 {
+		maxUses = defaultInviteUses
+	}
+	if ttl < minInviteTTL || ttl > maxInviteTTL {
+		return domain.RoomInvite{}, fmt.Errorf("%w: invite duration must be between %s and %s", domain.ErrInvalidInput, minInviteTTL, maxInviteTTL)
+	}
+	if maxUses < 1 || maxUses > maxInviteMaxUses {
+		return domain.RoomInvite{}, fmt.Errorf("%w: max uses must be between 1 and %d", domain.ErrInvalidInput, maxInviteMaxUses)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.RoomInvite{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := lockRoomWithPermission(ctx, tx, roomID, actorID, domain.PermManageInvites); err != nil {
+		return domain.RoomInvite{}, err
+	}
+
+	token, err := generateInviteToken()
+	if err != nil {
+		return domain.RoomInvite{}, err
+	}
+
+	invite := domain.RoomInvite{
+		RoomID:    roomID,
+		Token:     token,
+		CreatedBy: actorID,
+		MaxUses:   maxUses,
+	}
+	err = tx.QueryRowContext(ctx,
+		`INSERT INTO room_invites (room_id, token, created_by, expires_at, max_uses)
+		 VALUES ($1, $2, $3, NOW() + make_interval(secs => $4), $5)
+		 RETURNING id, expires_at, created_at`,
+		roomID, token, actorID, ttl.Seconds(), maxUses,
+	).Scan(&invite.ID, &invite.ExpiresAt, &invite.CreatedAt)
+	if err != nil {
+		return domain.RoomInvite{}, fmt.Errorf("failed to create invite: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return domain.RoomInvite{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("Invite %s created for room %s by %s (max_uses=%d, expires_at=%s)", invite.ID, roomID, actorID, maxUses, invite.ExpiresAt)
+	return invite, nil
+}
+
+// GetRoomInvites, odanın hâlâ kullanılabilir (iptal edilmemiş, süresi dolmamış, hakkı bitmemiş) davetlerini döner.
+func (r *Repository) GetRoomInvites(ctx context.Context, roomID, actorID uuid.UUID) ([]domain.RoomInvite, error) {
+	role, err := roomRole(ctx, r.db, roomID, actorID, false)
+	if err != nil {
+		return nil, err
+	}
+	if !role.Can(domain.PermManageInvites) {
+		return nil, domain.NewPermissionError(role, domain.PermManageInvites)
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT id, room_id, token, created_by, expires_at, max_uses, uses, created_at
+		 FROM room_invites
+		 WHERE room_id = $1 AND revoked_at IS NULL AND expires_at > NOW() AND uses < max_uses
+		 ORDER BY created_at DESC`,
+		roomID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invites: %w", err)
+	}
+	defer rows.Close()
+
+	invites := []domain.RoomInvite{}
+	for rows.Next() {
+		var invite domain.RoomInvite
+		if err := rows.Scan(&invite.ID, &invite.RoomID, &invite.Token, &invite.CreatedBy,
+			&invite.ExpiresAt, &invite.MaxUses, &invite.Uses, &invite.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan invite: %w", err)
+		}
+		invites = append(invites, invite)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate invites: %w", err)
+	}
+	return invites, nil
+}
+
+// RevokeRoomInvite, daveti iptal eder; bağlantı artık kullanılamaz.
+func (r *Repository) RevokeRoomInvite(ctx context.Context, roomID, actorID, inviteID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := lockRoomWithPermission(ctx, tx, roomID, actorID, domain.PermManageInvites); err != nil {
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx,
+		`UPDATE room_invites SET revoked_at = NOW()
+		 WHERE id = $1 AND room_id = $2 AND revoked_at IS NULL`,
+		inviteID, roomID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke invite: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: invite not found", domain.ErrNotFound)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("Invite %s of room %s revoked by %s", inviteID, roomID, actorID)
+	return nil
+}
+
+// GetInviteRoomID, davet token'ının ait olduğu odayı döner. Davetin geçerliliği burada kontrol edilmez.
+func (r *Repository) GetInviteRoomID(ctx context.Context, token string) (uuid.UUID, error) {
+	var roomID uuid.UUID
+	err := r.db.QueryRowContext(ctx, `SELECT room_id FROM room_invites WHERE token = $1`, token).Scan(&roomID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, fmt.Errorf("%w: invite not found", domain.ErrNotFound)
+		}
+		return uuid.Nil, fmt.Errorf("failed to query invite: %w", err)
+	}
+	return roomID, nil
+}
+
+// JoinRoomWithInvite, geçerli bir davet ile kullanıcıyı odaya ekler. Özel oda kodu kontrolü atlanır
+// ve davetin kullanım sayısı aynı transaction içinde artırılır.
+func (r *Repository) JoinRoomWithInvite(ctx context.Context, token string, userID uuid.UUID, allowLateJoin bool) (uuid.UUID, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Aynı davetin eşzamanlı kullanımlarında max_uses aşılmasın diye satır kilitlenir
+	var inviteID, roomID uuid.UUID
+	var revoked, expired, exhausted bool
+	err = tx.QueryRowContext(ctx,
+		`SELECT id, room_id, revoked_at IS NOT NULL, expires_at <= NOW(), uses >= max_uses
+		 FROM room_invites WHERE token = $1 FOR UPDATE`,
+		token,
+	).Scan(&inviteID, &roomID, &revoked, &expired, &exhausted)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, fmt.Errorf("%w: invite not found", domain.ErrNotFound)
+		}
+		return uuid.Nil, fmt.Errorf("failed to query invite: %w", err)
+	}
+
+	switch {
+	case revoked:
+		return uuid.Nil, fmt.Errorf("%w: invite has been revoked", domain.ErrForbidden)
+	case expired:
+		return uuid.Nil, fmt.Errorf("%w: invite has expired", domain.ErrForbidden)
+	case exhausted:
+		return uuid.Nil, fmt.Errorf("%w: invite has reached its maximum number of uses", domain.ErrForbidden)
+	}
+
+	if err := joinRoomTx(ctx, tx, roomID, userID, "", allowLateJoin, true); err != nil {
+		return uuid.Nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE room_invites SET uses = uses + 1 WHERE id = $1`, inviteID); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to update invite uses: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("User %s joined room %s with invite %s", userID, roomID, inviteID)
+	return roomID, nil
+}
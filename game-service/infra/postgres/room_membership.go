@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"game-service/domain"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// SetPlayerOnline, üyenin çevrimiçi durumunu ve son görülme zamanını günceller.
+func (r *Repository) SetPlayerOnline(ctx context.Context, roomID, userID uuid.UUID, online bool) error {
+	_, err := r.db.ExecContext(ctx,
+		`UPDATE room_players SET is_online = $3, last_seen_at = NOW()
+		 WHERE room_id = $1 AND user_id = $2 AND is_banned = FALSE`,
+		roomID, userID, online,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update player online status: %w", err)
+	}
+	return nil
+}
+
+// GetStaleMembers, son görülme zamanı verilen süreden eski olan üyeleri döner.
+// Bağlı olup olmadıklarına hub karar verir; bu sorgu sadece adayları listeler.
+func (r *Repository) GetStaleMembers(ctx context.Context, offlineFor time.Duration) ([]domain.RoomMember, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT room_id, user_id FROM room_players
+		 WHERE is_banned = FALSE AND last_seen_at < NOW() - make_interval(secs => $1)`,
+		offlineFor.Seconds(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stale members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []domain.RoomMember
+	for rows.Next() {
+		var member domain.RoomMember
+		if err := rows.Scan(&member.RoomID, &member.UserID); err != nil {
+			return nil, fmt.Errorf("failed to scan stale member: %w", err)
+		}
+		members = append(members, member)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate stale members: %w", err)
+	}
+	return members, nil
+}
+
+// ReconcileRoomPlayerCounts, rooms.current_players değerini gerçek üye sayısıyla eşitler
+// ve değeri düzeltilen odaların ID'lerini döner.
+func (r *Repository) ReconcileRoomPlayerCounts(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`UPDATE rooms r SET current_players = c.member_count
+		 FROM (
+			SELECT r2.id, COUNT(rp.user_id) AS member_count
+			FROM rooms r2
+			LEFT JOIN room_players rp ON rp.room_id = r2.id AND rp.is_banned = FALSE
+			GROUP BY r2.id
+		 ) c
+		 WHERE r.id = c.id AND r.current_players IS DISTINCT FROM c.member_count
+		 RETURNING r.id`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconcile player counts: %w", err)
+	}
+	return scanRoomIDs(rows, "reconciled")
+}
+
+// DeleteEmptyRooms, hiç üyesi kalmamış odaları siler ve silinen odaların ID'lerini döner.
+func (r *Repository) DeleteEmptyRooms(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`DELETE FROM rooms r
+		 WHERE NOT EXISTS (
+			SELECT 1 FROM room_players rp WHERE rp.room_id = r.id AND rp.is_banned = FALSE
+		 )
+		 RETURNING r.id`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete empty rooms: %w", err)
+	}
+	return scanRoomIDs(rows, "deleted empty")
+}
+
+// ResetRuntimeState, servis açılışında hiçbir instance'a bağlı olmayan kayıtları sıfırlar:
+// liveMembers dışında kimse çevrimiçi değildir ve bağlı üyesi olmayan odalardaki oyunlar artık devam etmez.
+// liveMembers, diğer instance'lara bağlı üyelerdir (Redis presence); onların kayıtlarına dokunulmaz.
+func (r *Repository) ResetRuntimeState(ctx context.Context, liveMembers []domain.RoomMember) error {
+	roomIDs := make([]string, 0, len(liveMembers))
+	userIDs := make([]string, 0, len(liveMembers))
+	for _, member := range liveMembers {
+		roomIDs = append(roomIDs, member.RoomID.String())
+		userIDs = append(userIDs, member.UserID.String())
+	}
+
+	if _, err := r.db.ExecContext(ctx,
+		`UPDATE room_players SET is_online = FALSE, last_seen_at = NOW()
+		 WHERE is_online = TRUE
+		   AND (room_id, user_id) NOT IN (SELECT * FROM unnest($1::uuid[], $2::uuid[]))`,
+		pq.Array(roomIDs), pq.Array(userIDs),
+	); err != nil {
+		return fmt.Errorf("failed to reset online status: %w", err)
+	}
+	if _, err := r.db.ExecContext(ctx,
+		`UPDATE rooms SET status = 'waiting' WHERE status <> 'waiting' AND id <> ALL($1::uuid[])`,
+		pq.Array(roomIDs),
+	); err != nil {
+		return fmt.Errorf("failed to reset room status: %w", err)
+	}
+	return nil
+}
+
+// scanRoomIDs, RETURNING id sorgularının sonucunu okur.
+func scanRoomIDs(rows *sql.Rows, action string) ([]uuid.UUID, error) {
+	defer rows.Close()
+
+	var roomIDs []uuid.UUID
+	for rows.Next() {
+		var roomID uuid.UUID
+		if err := rows.Scan(&roomID); err != nil {
+			return nil, fmt.Errorf("failed to scan room id: %w", err)
+		}
+		roomIDs = append(roomIDs, roomID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate room ids: %w", err)
+	}
+	if len(roomIDs) > 0 {
+		log.Printf("Cleanup %s %d rooms", action, len(roomIDs))
+	}
+	return roomIDs, nil
+}
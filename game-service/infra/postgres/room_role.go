@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"game-service/domain"
+
+	"github.com/google/uuid"
+)
+
+// queryRower, hem *sql.DB hem de *sql.Tx ile rol sorgusu yapabilmek için kullanılır.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// roomRole, kullanıcının odadaki rolünü döner. forUpdate true ise oda satırı kilitlenir.
+func roomRole(ctx context.Context, q queryRower, roomID, userID uuid.UUID, forUpdate bool) (domain.RoomRole, error) {
+	query := `
+		SELECT r.creator_id, rp.role
+		FROM rooms r
+		LEFT JOIN room_players rp ON rp.room_id = r.id AND rp.user_id = $2 AND rp.is_banned = FALSE
+		WHERE r.id = $1`
+	if forUpdate {
+		query += ` FOR UPDATE OF r`
+	}
+
+	var creatorID uuid.UUID
+	var role sql.NullString
+	err := q.QueryRowContext(ctx, query, roomID, userID).Scan(&creatorID, &role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("%w: room not found", domain.ErrNotFound)
+		}
+		return "", fmt.Errorf("failed to query room role: %w", err)
+	}
+
+	switch {
+	case creatorID == userID:
+		return domain.RoleHost, nil
+	case !role.Valid:
+		// Üye olmayanlar odayı sadece izleyebilir
+		return domain.RoleSpectator, nil
+	case domain.RoomRole(role.String) == domain.RoleCoHost:
+		return domain.RoleCoHost, nil
+	default:
+		return domain.RolePlayer, nil
+	}
+}
+
+// lockRoomWithPermission, oda satırını kilitler ve işlemi yapan kullanıcının yetkisini doğrular.
+func lockRoomWithPermission(ctx context.Context, tx *sql.Tx, roomID, actorID uuid.UUID, perm domain.Permission) (domain.RoomRole, error) {
+	role, err := roomRole(ctx, tx, roomID, actorID, true)
+	if err != nil {
+		return "", err
+	}
+	if !role.Can(perm) {
+		return role, domain.NewPermissionError(role, perm)
+	}
+	return role, nil
+}
+
+// checkOutranks, moderasyon hedefinin işlemi yapandan düşük rolde olduğunu doğrular.
+func checkOutranks(ctx context.Context, tx *sql.Tx, roomID, targetID uuid.UUID, actorRole domain.RoomRole, perm domain.Permission) error {
+	targetRole, err := roomRole(ctx, tx, roomID, targetID, false)
+	if err != nil {
+		return err
+	}
+	if !actorRole.Outranks(targetRole) {
+		permErr := domain.NewPermissionError(actorRole, perm)
+		permErr.Reason = fmt.Sprintf("cannot %s a user with role %q", perm, targetRole)
+		return permErr
+	}
+	return nil
+}
+
+// GetRoomRole, kullanıcının odadaki rolünü döner.
+func (r *Repository) GetRoomRole(ctx context.Context, roomID, userID uuid.UUID) (domain.RoomRole, error) {
+	return roomRole(ctx, r.db, roomID, userID, false)
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"game-service/domain"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// loadRoomSettings, odanın kayıtlı ayarlarını modun varsayılanlarının üzerine okur.
+// Oyuncu sınırları her zaman modun sınırlarına çekilir ve max_players rooms tablosundan alınır.
+func loadRoomSettings(ctx context.Context, q queryRower, roomID uuid.UUID) (domain.RoomSettings, domain.GameModeLimits, int, error) {
+	var (
+		raw            []byte
+		roomMaxPlayers int
+		currentPlayers int
+		modeID         int
+		modeName       string
+		limits         domain.GameModeLimits
+	)
+	err := q.QueryRowContext(ctx,
+		`SELECT r.settings, r.max_players, r.current_players, r.game_mode_id, gm.mode_name,
+		        gm.min_players, gm.max_players, gm.default_rounds, gm.default_round_duration
+		 FROM rooms r
+		 INNER JOIN game_modes gm ON r.game_mode_id = gm.id
+		 WHERE r.id = $1`,
+		roomID,
+	).Scan(&raw, &roomMaxPlayers, &currentPlayers, &modeID, &modeName,
+		&limits.MinPlayers, &limits.MaxPlayers, &limits.DefaultRounds, &limits.DefaultRoundDuration)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.RoomSettings{}, limits, 0, fmt.Errorf("%w: room not found", domain.ErrNotFound)
+		}
+		return domain.RoomSettings{}, limits, 0, fmt.Errorf("failed to query room settings: %w", err)
+	}
+
+	settings := domain.DefaultRoomSettings(limits, roomMaxPlayers)
+	if raw != nil {
+		if err := json.Unmarshal(raw, &settings); err != nil {
+			// Bozuk kayıt odayı kullanılamaz hale getirmesin; varsayılanlarla devam edilir.
+			log.Printf("Room %s has invalid settings, falling back to defaults: %v", roomID, err)
+			settings = domain.DefaultRoomSettings(limits, roomMaxPlayers)
+		}
+	}
+	settings.GameModeID = modeID
+	settings.ModeName = modeName
+	settings.MaxPlayers = roomMaxPlayers
+	settings.ClampPlayers(limits)
+
+	return settings, limits, currentPlayers, nil
+}
+
+// GetRoomSettings, odanın oyun ayarlarını döner. Ayar kaydedilmemişse modun varsayılanları döner.
+func (r *Repository) GetRoomSettings(ctx context.Context, roomID uuid.UUID) (domain.RoomSettings, error) {
+	settings, _, _, err := loadRoomSettings(ctx, r.db, roomID)
+	return settings, err
+}
+
+// UpdateRoomSettings, odanın ayarlarını kısmi olarak günceller. Yeni ayarlar modun oyuncu
+// sınırlarına göre doğrulanır; max_players rooms tablosuna da yazılır.
+func (r *Repository) UpdateRoomSettings(ctx context.Context, roomID, actorID uuid.UUID, patch domain.RoomSettingsPatch) (domain.RoomSettings, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return domain.RoomSettings{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := lockRoomWithPermission(ctx, tx, roomID, actorID, domain.PermChangeSettings); err != nil {
+		return domain.RoomSettings{}, err
+	}
+
+	settings, limits, currentPlayers, err := loadRoomSettings(ctx, tx, roomID)
+	if err != nil {
+		return domain.RoomSettings{}, err
+	}
+
+	settings.Apply(patch)
+	if err := settings.Validate(limits); err != nil {
+		return domain.RoomSettings{}, err
+	}
+	if settings.MaxPlayers < currentPlayers {
+		return domain.RoomSettings{}, fmt.Errorf("%w: max_players cannot be lower than the current player count (%d)", domain.ErrInvalidInput, currentPlayers)
+	}
+
+	raw, err := json.Marshal(settings)
+	if err != nil {
+		return domain.RoomSettings{}, fmt.Errorf("failed to encode room settings: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE rooms SET settings = $1, max_players = $2 WHERE id = $3`,
+		raw, settings.MaxPlayers, roomID,
+	); err != nil {
+		return domain.RoomSettings{}, fmt.Errorf("failed to update room settings: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.RoomSettings{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("Room %s settings updated by %s", roomID, actorID)
+	return settings, nil
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// SetPlayerRole, bir üyeyi co-host yapar veya tekrar oyuncuya düşürür. Sadece host yapabilir.
+func (r *Repository) SetPlayerRole(ctx context.Context, roomID, actorID, targetID uuid.UUID, role domain.RoomRole) error {
+	if role != domain.RoleCoHost && role != domain.RolePlayer {
+		return fmt.Errorf("%w: role must be %q or %q", domain.ErrInvalidInput, domain.RoleCoHost, domain.RolePlayer)
+	}
+	if actorID == targetID {
+		return fmt.Errorf("%w: host cannot change their own role", domain.ErrInvalidInput)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := lockRoomWithPermission(ctx, tx, roomID, actorID, domain.PermManageRoles); err != nil {
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx,
+		`UPDATE room_players SET role = $3 WHERE room_id = $1 AND user_id = $2 AND is_banned = FALSE`,
+		roomID, targetID, string(role),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update player role: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: user is not in the room", domain.ErrNotFound)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("User %s role set to %s in room %s by %s", targetID, role, roomID, actorID)
+	return nil
+}
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// TransferHost, oda sahipliğini odadaki başka bir üyeye devreder. Sadece mevcut host yapabilir.
+// Eski host odada normal oyuncu olarak kalır.
+func (r *Repository) TransferHost(ctx context.Context, roomID, hostID, targetID uuid.UUID) error {
+	if hostID == targetID {
+		return fmt.Errorf("%w: user is already the host", domain.ErrInvalidInput)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := lockRoomWithPermission(ctx, tx, roomID, hostID, domain.PermManageRoles); err != nil {
+		return err
+	}
+
+	// Yeni host üye olmalı; host rolü rooms.creator_id ile tutulduğu için satırdaki rol sıfırlanır.
+	res, err := tx.ExecContext(ctx,
+		`UPDATE room_players SET role = 'player' WHERE room_id = $1 AND user_id = $2 AND is_banned = FALSE`,
+		roomID, targetID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update new host membership: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: user is not in the room", domain.ErrNotFound)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`UPDATE rooms SET creator_id = $1 WHERE id = $2`,
+		targetID, roomID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update room host: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("Room %s host transferred from %s to %s", roomID, hostID, targetID)
+	return nil
+}
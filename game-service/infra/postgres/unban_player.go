@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// UnbanPlayer, oyuncunun banını kaldırır. Oyuncu tekrar katılmak için join-room kullanmalıdır.
+func (r *Repository) UnbanPlayer(ctx context.Context, roomID, hostID, targetID uuid.UUID) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := lockRoomWithPermission(ctx, tx, roomID, hostID, domain.PermBanPlayer); err != nil {
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx,
+		`DELETE FROM room_players WHERE room_id = $1 AND user_id = $2 AND is_banned = TRUE`,
+		roomID, targetID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to unban player: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: user is not banned from the room", domain.ErrNotFound)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.Printf("User %s unbanned from room %s by host %s", targetID, roomID, hostID)
+	return nil
+}
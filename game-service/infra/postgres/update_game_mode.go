@@ -2,79 +2,59 @@ package postgres
 
 import (
 	"context"
-	"database/sql"
-	"errors"
 	"fmt"
 	"game-service/domain"
 	"log"
-	"strings"
 
 	"github.com/google/uuid"
 )
 
-func (r *Repository) UpdateRoomGameMode(ctx context.Context, roomID uuid.UUID, userID uuid.UUID, newGameModeID int) error {
-	// 1. Oda yaratıcısının kim olduğunu ve odanın varlığını kontrol et.
-	// Aynı zamanda yeni game_mode_id'nin var olup olmadığını kontrol etmek de iyi bir fikirdir.
-
-	checkQuery := `
-		SELECT 
-			creator_id
-		FROM 
-			rooms
-		WHERE 
-			id = $1
-	`
-	var creatorID uuid.UUID
-	err := r.db.QueryRowContext(ctx, checkQuery, roomID).Scan(&creatorID)
-
+// UpdateRoomGameMode, odanın oyun modunu değiştirir ve yeni modun katalog kaydını döner.
+// Odanın kapasitesi yeni modun oyuncu sınırlarına çekilir.
+func (r *Repository) UpdateRoomGameMode(ctx context.Context, roomID uuid.UUID, userID uuid.UUID, newGameModeID int) (domain.GameMode, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			// Oda bulunamadı hatası
-			return fmt.Errorf("%w: room not found with ID %s", domain.ErrNotFound, roomID)
-		}
-		// Diğer beklenmedik veritabanı hataları
-		return fmt.Errorf("failed to check room creator: %w", err)
+		return domain.GameMode{}, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	// 2. Kullanıcının oda yaratıcısı olup olmadığını kontrol et.
-	if creatorID != userID {
-		// Yetkilendirme hatası
-		return fmt.Errorf("%w: only the room creator can change the game mode", domain.ErrForbidden)
+	// 1. Kullanıcının mod değiştirme yetkisi (host/co-host) olup olmadığını kontrol et; oda satırı kilitlenir.
+	if _, err := lockRoomWithPermission(ctx, tx, roomID, userID, domain.PermChangeMode); err != nil {
+		return domain.GameMode{}, err
 	}
 
-	// 3. Oyun modunu güncelleme sorgusunu hazırla.
-	updateQuery := `
-		UPDATE 
-			rooms
-		SET 
-			game_mode_id = $1
-		WHERE 
-			id = $2
-	`
-
-	// 4. Güncelleme işlemini gerçekleştir.
-	result, err := r.db.ExecContext(ctx, updateQuery, newGameModeID, roomID)
+	// 2. Yeni mod katalogda var mı?
+	mode, err := gameModeByID(ctx, tx, newGameModeID)
 	if err != nil {
-		// Örneğin, FOREIGN KEY hatası (game_mode_id'nin game_modes tablosunda olmaması) kontrol edilebilir.
-		// PostgreSQL hata mesajını kontrol etmek zor olduğundan, bu kontrolü yapan ayrı bir sorgu daha güvenilirdir.
-		if strings.Contains(err.Error(), "foreign key constraint") {
-			return fmt.Errorf("%w: game mode ID %d does not exist", domain.ErrInvalidInput, newGameModeID)
-		}
-		return fmt.Errorf("failed to update room game mode: %w", err)
+		return domain.GameMode{}, err
 	}
 
-	// 5. Kaç satırın etkilendiğini kontrol et (güncellemenin başarılı olup olmadığını doğrulamak için).
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("failed to check rows affected: %w", err)
+	// 3. Kapasiteyi yeni modun sınırlarına çek; odadakiler yeni modun üst sınırını aşmamalı.
+	var maxPlayers, currentPlayers int
+	if err := tx.QueryRowContext(ctx,
+		`SELECT max_players, current_players FROM rooms WHERE id = $1`, roomID,
+	).Scan(&maxPlayers, &currentPlayers); err != nil {
+		return domain.GameMode{}, fmt.Errorf("failed to query room capacity: %w", err)
+	}
+	if currentPlayers > mode.MaxPlayers {
+		return domain.GameMode{}, fmt.Errorf("%w: room has %d players but %s allows at most %d",
+			domain.ErrInvalidInput, currentPlayers, mode.Name, mode.MaxPlayers)
+	}
+	maxPlayers = max(maxPlayers, mode.MinPlayers, currentPlayers)
+	maxPlayers = min(maxPlayers, mode.MaxPlayers)
+
+	// 4. Güncelleme işlemini gerçekleştir.
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE rooms SET game_mode_id = $1, max_players = $2 WHERE id = $3`,
+		newGameModeID, maxPlayers, roomID,
+	); err != nil {
+		return domain.GameMode{}, fmt.Errorf("failed to update room game mode: %w", err)
 	}
 
-	if rowsAffected == 0 {
-		// Bu kısma normalde düşülmemeli (çünkü varlık kontrolü daha önce yapıldı),
-		// ancak yine de bir güvenlik kontrolü olarak tutulabilir.
-		return fmt.Errorf("%w: room not found or no change was needed", domain.ErrNotFound)
+	if err := tx.Commit(); err != nil {
+		return domain.GameMode{}, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	log.Printf("Room %s game mode updated to %d by creator %s", roomID, newGameModeID, userID)
-	return nil
+	log.Printf("Room %s game mode updated to %d by %s", roomID, newGameModeID, userID)
+	return mode, nil
 }
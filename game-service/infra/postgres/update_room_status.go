@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// roomStatusQueries, her hedef durum için geçerli geçişi yapan sorgudur.
+// waiting → playing → finished → waiting; geçersiz veya tekrarlanan geçişler hiçbir satırı etkilemez.
+var roomStatusQueries = map[string]string{
+	domain.RoomStatusPlaying: `UPDATE rooms SET status = 'playing', started_at = NOW(), finished_at = NULL
+		WHERE id = $1 AND status <> 'playing'`,
+	domain.RoomStatusFinished: `UPDATE rooms SET status = 'finished', finished_at = NOW()
+		WHERE id = $1 AND status = 'playing'`,
+	domain.RoomStatusWaiting: `UPDATE rooms SET status = 'waiting'
+		WHERE id = $1 AND status = 'finished'`,
+}
+
+// UpdateRoomStatus, odanın durumunu oyun yaşam döngüsüne göre günceller.
+// Durum değiştiyse true döner; oda yoksa veya zaten o durumdaysa false döner.
+func (r *Repository) UpdateRoomStatus(ctx context.Context, roomID uuid.UUID, status string) (bool, error) {
+	query, ok := roomStatusQueries[status]
+	if !ok {
+		return false, fmt.Errorf("%w: invalid room status %q", domain.ErrInvalidInput, status)
+	}
+
+	res, err := r.db.ExecContext(ctx, query, roomID)
+	if err != nil {
+		return false, fmt.Errorf("failed to update room status: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	log.Printf("Room %s status changed to %s", roomID, status)
+	return true, nil
+}
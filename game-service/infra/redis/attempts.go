@@ -0,0 +1,37 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// IncrementAttempts, verilen anahtarın sayacını artırır. Sayaç ilk kez oluşturulduğunda
+// window süresi sonunda kendiliğinden silinir (sabit pencere).
+func (rm *RedisManager) IncrementAttempts(ctx context.Context, key string, window time.Duration) (int64, error) {
+	count, err := rm.client.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment attempts: %w", err)
+	}
+	if count == 1 {
+		if err := rm.client.Expire(ctx, key, window).Err(); err != nil {
+			return count, fmt.Errorf("failed to set attempts expiry: %w", err)
+		}
+	}
+	return count, nil
+}
+
+// GetAttempts, anahtarın mevcut sayacını döner. Anahtar yoksa 0 döner.
+func (rm *RedisManager) GetAttempts(ctx context.Context, key string) (int64, error) {
+	count, err := rm.client.Get(ctx, key).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get attempts: %w", err)
+	}
+	return count, nil
+}
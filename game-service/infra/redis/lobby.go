@@ -0,0 +1,33 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LobbyChannel, oda listesi değişikliklerinin (oluşturma, güncelleme, silme) yayınlandığı kanaldır.
+const LobbyChannel = "lobby"
+
+// PublishLobbyEvent, lobi akışına oda olayı yayınlar (MSG_ROOM_CREATED, MSG_ROOM_UPDATED, MSG_ROOM_DELETED).
+func (rm *RedisManager) PublishLobbyEvent(ctx context.Context, msgType string, roomID uuid.UUID, data interface{}) {
+	msg := PubSubMessage{
+		Type:      msgType,
+		RoomID:    roomID.String(),
+		Data:      data,
+		Timestamp: time.Now(),
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		log.Printf("Failed to marshal lobby event: %v", err)
+		return
+	}
+
+	if err := rm.client.Publish(ctx, LobbyChannel, payload).Err(); err != nil {
+		log.Printf("Failed to publish lobby event %s for room %s: %v", msgType, roomID, err)
+	}
+}
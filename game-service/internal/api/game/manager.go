@@ -18,13 +18,6 @@ type RoomManager struct {
 	mu        sync.RWMutex
 }
 
-// GameModes, mevcut oyun modları
-var GameModes = map[int]*GameMode{
-	1: {ID: 1, Name: "Çizim ve Tahmin", Description: "Her oyuncu bir kelime yazar, diğerleri bu kelimeleri çizmeye çalışır", MinPlayers: 2, MaxPlayers: 8},
-	2: {ID: 2, Name: "Ortak Alan", Description: "Tüm oyuncular aynı canvas üzerinde birlikte çizim yapar", MinPlayers: 2, MaxPlayers: 12},
-	3: {ID: 3, Name: "Serbest Çizim", Description: "Herkes istediği gibi çizim yapabilir, yarışma yok", MinPlayers: 1, MaxPlayers: 20},
-}
-
 // NewRoomManager, yeni bir RoomManager örneği oluşturur
 func NewRoomManager(db *sql.DB) *RoomManager {
 	rm := &RoomManager{
@@ -46,8 +39,10 @@ func NewRoomManager(db *sql.DB) *RoomManager {
 func (rm *RoomManager) loadActiveRooms() {
 	query := `
 		SELECT r.id, r.room_name, r.creator_id, r.game_mode_id, r.max_players,
-		       r.status, r.is_private, r.room_code, r.created_at, r.started_at
+		       r.status, r.is_private, r.room_code, r.created_at, r.started_at,
+		       gm.mode_name, COALESCE(gm.description, ''), gm.min_players, gm.max_players
 		FROM rooms r 
+		INNER JOIN game_modes gm ON r.game_mode_id = gm.id
 		WHERE r.status IN ('waiting', 'playing') 
 		AND r.created_at > NOW() - INTERVAL '24 hours'`
 
@@ -67,11 +62,13 @@ func (rm *RoomManager) loadActiveRooms() {
 
 		var startedAt sql.NullTime
 		var roomCode sql.NullString
+		gameMode := &GameMode{}
 
 		err := rows.Scan(
 			&room.ID, &room.Name, &room.CreatorID, &room.GameModeID,
 			&room.MaxPlayers, &room.Status, &room.IsPrivate,
 			&roomCode, &room.CreatedAt, &startedAt,
+			&gameMode.Name, &gameMode.Description, &gameMode.MinPlayers, &gameMode.MaxPlayers,
 		)
 		if err != nil {
 			log.Errorf("Oda verisi okunurken hata: %v", err)
@@ -86,9 +83,8 @@ func (rm *RoomManager) loadActiveRooms() {
 		}
 
 		// Oyun modunu ekle
-		if gameMode, exists := GameModes[room.GameModeID]; exists {
-			room.GameMode = gameMode
-		}
+		gameMode.ID = room.GameModeID
+		room.GameMode = gameMode
 
 		rm.mu.Lock()
 		rm.Rooms[room.ID] = room
@@ -175,13 +171,25 @@ func (rm *RoomManager) RemovePlayerFromRoom(roomID, userID string) error {
 	return nil
 }
 
-// GetGameModes, mevcut oyun modlarını döndürür
-func (rm *RoomManager) GetGameModes() []*GameMode {
-	modes := make([]*GameMode, 0, len(GameModes))
-	for _, mode := range GameModes {
+// GetGameModes, game_modes tablosundaki oyun modlarını döndürür
+func (rm *RoomManager) GetGameModes() ([]*GameMode, error) {
+	rows, err := rm.DB.Query(`
+		SELECT id, mode_name, COALESCE(description, ''), min_players, max_players
+		FROM game_modes ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("oyun modları alınamadı: %v", err)
+	}
+	defer rows.Close()
+
+	modes := make([]*GameMode, 0)
+	for rows.Next() {
+		mode := &GameMode{}
+		if err := rows.Scan(&mode.ID, &mode.Name, &mode.Description, &mode.MinPlayers, &mode.MaxPlayers); err != nil {
+			return nil, fmt.Errorf("oyun modu okunamadı: %v", err)
+		}
 		modes = append(modes, mode)
 	}
-	return modes
+	return modes, rows.Err()
 }
 
 // GetRandomWord, rastgele bir kelime döndürür (Çizim ve Tahmin modu için)
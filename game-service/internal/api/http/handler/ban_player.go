@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type BanPlayerRequest struct {
+	RoomID uuid.UUID `params:"room_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type BanPlayerResponse struct {
+	Message string `json:"message"`
+}
+
+type BanPlayerHandler struct {
+	usecase httpUsecase.BanPlayerUseCase
+}
+
+func NewBanPlayerHandler(usecase httpUsecase.BanPlayerUseCase) *BanPlayerHandler {
+	return &BanPlayerHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *BanPlayerHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *BanPlayerRequest) (*BanPlayerResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, err := h.usecase.Execute(ctx, req.RoomID, userID, req.UserID)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &BanPlayerResponse{Message: "Player banned"}, status, nil
+}
@@ -15,11 +15,12 @@ type CreateRoomRequest struct {
 	MaxPlayers int    `json:"max_players"`
 	GameModeID int    `json:"game_mode_id"`
 	IsPrivate  bool   `json:"is_private"`
-	RoomCode   string `json:"room_code"`
 }
 
 type CreateRoomResponse struct {
-	Message string `json:"message"`
+	Message  string    `json:"message"`
+	RoomID   uuid.UUID `json:"room_id"`
+	RoomCode string    `json:"room_code,omitempty"`
 }
 
 type CreateRoomHandler struct {
@@ -50,13 +51,12 @@ func (h *CreateRoomHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *
 		MaxPlayers: req.MaxPlayers,
 		GameModeID: req.GameModeID,
 		IsPrivate:  req.IsPrivate,
-		RoomCode:   req.RoomCode,
 		CreatorID:  userID,
 	}
-	status, err := h.usecase.Execute(ctx, data)
+	status, roomID, roomCode, err := h.usecase.Execute(ctx, data)
 	if err != nil {
 		return nil, status, err
 	}
 
-	return &CreateRoomResponse{Message: "CreateRoom user "}, status, nil
+	return &CreateRoomResponse{Message: "CreateRoom user ", RoomID: roomID, RoomCode: roomCode}, status, nil
 }
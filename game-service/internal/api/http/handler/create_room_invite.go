@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CreateRoomInviteRequest struct {
+	RoomID           uuid.UUID `params:"room_id"`
+	ExpiresInMinutes int       `json:"expires_in_minutes" validate:"gte=0,lte=10080"`
+	MaxUses          int       `json:"max_uses" validate:"gte=0,lte=100"`
+}
+
+type CreateRoomInviteResponse struct {
+	Message string             `json:"message"`
+	Invite  *domain.RoomInvite `json:"invite"`
+}
+
+type CreateRoomInviteHandler struct {
+	usecase httpUsecase.CreateRoomInviteUseCase
+}
+
+func NewCreateRoomInviteHandler(usecase httpUsecase.CreateRoomInviteUseCase) *CreateRoomInviteHandler {
+	return &CreateRoomInviteHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *CreateRoomInviteHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *CreateRoomInviteRequest) (*CreateRoomInviteResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, invite, err := h.usecase.Execute(ctx, req.RoomID, userID, req.ExpiresInMinutes, req.MaxUses)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &CreateRoomInviteResponse{Message: "Invite created", Invite: invite}, status, nil
+}
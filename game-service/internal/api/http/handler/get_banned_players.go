@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type GetBannedPlayersRequest struct {
+	RoomID uuid.UUID `params:"room_id"`
+}
+
+type GetBannedPlayersResponse struct {
+	Message string                `json:"message"`
+	Players []domain.BannedPlayer `json:"players"`
+}
+
+type GetBannedPlayersHandler struct {
+	usecase httpUsecase.GetBannedPlayersUseCase
+}
+
+func NewGetBannedPlayersHandler(usecase httpUsecase.GetBannedPlayersUseCase) *GetBannedPlayersHandler {
+	return &GetBannedPlayersHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *GetBannedPlayersHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *GetBannedPlayersRequest) (*GetBannedPlayersResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, players, err := h.usecase.Execute(ctx, req.RoomID, userID)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &GetBannedPlayersResponse{Message: "Banned players", Players: players}, status, nil
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"context"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetGameModesRequest struct{}
+
+type GetGameModesResponse struct {
+	Message   string            `json:"message"`
+	GameModes []domain.GameMode `json:"game_modes"`
+}
+
+type GetGameModesHandler struct {
+	usecase httpUsecase.GetGameModesUseCase
+}
+
+func NewGetGameModesHandler(usecase httpUsecase.GetGameModesUseCase) *GetGameModesHandler {
+	return &GetGameModesHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *GetGameModesHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *GetGameModesRequest) (*GetGameModesResponse, int, error) {
+	if fbrCtx.Get("X-User-ID") == "" {
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+
+	status, modes, err := h.usecase.Execute(ctx)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &GetGameModesResponse{Message: "Game modes", GameModes: modes}, status, nil
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type GetGameSummariesRequest struct {
+	RoomID uuid.UUID `params:"room_id"`
+	Limit  int       `query:"limit" validate:"gte=0,lte=50"`
+}
+
+type GetGameSummariesResponse struct {
+	Message   string               `json:"message"`
+	Summaries []domain.GameSummary `json:"summaries"`
+}
+
+type GetGameSummariesHandler struct {
+	usecase httpUsecase.GetGameSummariesUseCase
+}
+
+func NewGetGameSummariesHandler(usecase httpUsecase.GetGameSummariesUseCase) *GetGameSummariesHandler {
+	return &GetGameSummariesHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *GetGameSummariesHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *GetGameSummariesRequest) (*GetGameSummariesResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, summaries, err := h.usecase.Execute(ctx, req.RoomID, userID, req.Limit)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &GetGameSummariesResponse{Message: "Game summaries", Summaries: summaries}, status, nil
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type GetRoomInvitesRequest struct {
+	RoomID uuid.UUID `params:"room_id"`
+}
+
+type GetRoomInvitesResponse struct {
+	Message string              `json:"message"`
+	Invites []domain.RoomInvite `json:"invites"`
+}
+
+type GetRoomInvitesHandler struct {
+	usecase httpUsecase.GetRoomInvitesUseCase
+}
+
+func NewGetRoomInvitesHandler(usecase httpUsecase.GetRoomInvitesUseCase) *GetRoomInvitesHandler {
+	return &GetRoomInvitesHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *GetRoomInvitesHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *GetRoomInvitesRequest) (*GetRoomInvitesResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, invites, err := h.usecase.Execute(ctx, req.RoomID, userID)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &GetRoomInvitesResponse{Message: "Room invites", Invites: invites}, status, nil
+}
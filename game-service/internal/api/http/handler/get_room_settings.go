@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type GetRoomSettingsRequest struct {
+	RoomID uuid.UUID `params:"room_id"`
+}
+
+type GetRoomSettingsResponse struct {
+	Message  string              `json:"message"`
+	Settings domain.RoomSettings `json:"settings"`
+}
+
+type GetRoomSettingsHandler struct {
+	usecase httpUsecase.GetRoomSettingsUseCase
+}
+
+func NewGetRoomSettingsHandler(usecase httpUsecase.GetRoomSettingsUseCase) *GetRoomSettingsHandler {
+	return &GetRoomSettingsHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *GetRoomSettingsHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *GetRoomSettingsRequest) (*GetRoomSettingsResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, settings, err := h.usecase.Execute(ctx, req.RoomID, userID)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &GetRoomSettingsResponse{Message: "Room settings", Settings: settings}, status, nil
+}
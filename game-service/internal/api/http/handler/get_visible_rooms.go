@@ -11,11 +11,20 @@ import (
 )
 
 type GetVisibleRoomsRequest struct {
+	GameModeID   int    `query:"game_mode_id" validate:"gte=0"`
+	LanguageCode string `query:"language" validate:"omitempty,max=10"`
+	HasFreeSeats bool   `query:"free_seats"`
+	OnlyPrivate  bool   `query:"private"`
+	Search       string `query:"search" validate:"omitempty,max=100"`
+	Sort         string `query:"sort" validate:"omitempty,oneof=newest fullest about_to_start"`
+	Cursor       string `query:"cursor" validate:"omitempty,max=512"`
+	Limit        int    `query:"limit" validate:"gte=0,lte=50"`
 }
 
 type GetVisibleRoomsResponse struct {
-	Message string        `json:"message"`
-	Rooms   []domain.Room `json:"rooms"`
+	Message    string        `json:"message"`
+	Rooms      []domain.Room `json:"rooms"`
+	NextCursor string        `json:"next_cursor,omitempty"`
 }
 
 type GetVisibleRoomsHandler struct {
@@ -41,10 +50,20 @@ func (h *GetVisibleRoomsHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context,
 
 	}
 
-	status, rooms, err := h.usecase.Execute(ctx, userID)
+	filter := domain.RoomListFilter{
+		GameModeID:   req.GameModeID,
+		LanguageCode: req.LanguageCode,
+		HasFreeSeats: req.HasFreeSeats,
+		OnlyPrivate:  req.OnlyPrivate,
+		Search:       req.Search,
+		Sort:         req.Sort,
+		Cursor:       req.Cursor,
+		Limit:        req.Limit,
+	}
+	status, rooms, nextCursor, err := h.usecase.Execute(ctx, userID, filter)
 	if err != nil {
 		return nil, status, err
 	}
 
-	return &GetVisibleRoomsResponse{Message: " GetVisibleRooms user ", Rooms: rooms}, status, nil
+	return &GetVisibleRoomsResponse{Message: " GetVisibleRooms user ", Rooms: rooms, NextCursor: nextCursor}, status, nil
 }
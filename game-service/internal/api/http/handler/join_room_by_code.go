@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type JoinRoomByCodeRequest struct {
+	RoomCode string `json:"room_code" validate:"required,max=16"`
+}
+
+type JoinRoomByCodeResponse struct {
+	Message string    `json:"message"`
+	RoomID  uuid.UUID `json:"room_id"`
+}
+
+type JoinRoomByCodeHandler struct {
+	usecase httpUsecase.JoinRoomByCodeUseCase
+}
+
+func NewJoinRoomByCodeHandler(usecase httpUsecase.JoinRoomByCodeUseCase) *JoinRoomByCodeHandler {
+	return &JoinRoomByCodeHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *JoinRoomByCodeHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *JoinRoomByCodeRequest) (*JoinRoomByCodeResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, roomID, err := h.usecase.Execute(ctx, userID, req.RoomCode)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &JoinRoomByCodeResponse{Message: "Joined room", RoomID: roomID}, status, nil
+}
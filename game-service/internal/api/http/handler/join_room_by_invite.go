@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type JoinRoomByInviteRequest struct {
+	Token string `json:"token" validate:"required,max=64"`
+}
+
+type JoinRoomByInviteResponse struct {
+	Message string    `json:"message"`
+	RoomID  uuid.UUID `json:"room_id"`
+}
+
+type JoinRoomByInviteHandler struct {
+	usecase httpUsecase.JoinRoomByInviteUseCase
+}
+
+func NewJoinRoomByInviteHandler(usecase httpUsecase.JoinRoomByInviteUseCase) *JoinRoomByInviteHandler {
+	return &JoinRoomByInviteHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *JoinRoomByInviteHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *JoinRoomByInviteRequest) (*JoinRoomByInviteResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, roomID, err := h.usecase.Execute(ctx, userID, req.Token)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &JoinRoomByInviteResponse{Message: "Joined room", RoomID: roomID}, status, nil
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type QuickPlayRequest struct {
+	GameModeID   int    `json:"game_mode_id" validate:"gte=0"`
+	LanguageCode string `json:"language_code" validate:"omitempty,max=10"`
+}
+
+type QuickPlayResponse struct {
+	Message string    `json:"message"`
+	RoomID  uuid.UUID `json:"room_id"`
+	Created bool      `json:"created"`
+}
+
+type QuickPlayHandler struct {
+	usecase httpUsecase.QuickPlayUseCase
+}
+
+func NewQuickPlayHandler(usecase httpUsecase.QuickPlayUseCase) *QuickPlayHandler {
+	return &QuickPlayHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *QuickPlayHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *QuickPlayRequest) (*QuickPlayResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, roomID, created, err := h.usecase.Execute(ctx, userID, req.GameModeID, req.LanguageCode)
+	if err != nil {
+		return nil, status, err
+	}
+
+	message := "Joined room"
+	if created {
+		message = "Created room"
+	}
+	return &QuickPlayResponse{Message: message, RoomID: roomID, Created: created}, status, nil
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type RegenerateRoomCodeRequest struct {
+	RoomID uuid.UUID `params:"room_id"`
+}
+
+type RegenerateRoomCodeResponse struct {
+	Message  string `json:"message"`
+	RoomCode string `json:"room_code"`
+}
+
+type RegenerateRoomCodeHandler struct {
+	usecase httpUsecase.RegenerateRoomCodeUseCase
+}
+
+func NewRegenerateRoomCodeHandler(usecase httpUsecase.RegenerateRoomCodeUseCase) *RegenerateRoomCodeHandler {
+	return &RegenerateRoomCodeHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *RegenerateRoomCodeHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *RegenerateRoomCodeRequest) (*RegenerateRoomCodeResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, roomCode, err := h.usecase.Execute(ctx, req.RoomID, userID)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &RegenerateRoomCodeResponse{Message: "Room code regenerated", RoomCode: roomCode}, status, nil
+}
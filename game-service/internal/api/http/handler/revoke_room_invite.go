@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type RevokeRoomInviteRequest struct {
+	RoomID   uuid.UUID `params:"room_id"`
+	InviteID uuid.UUID `params:"invite_id"`
+}
+
+type RevokeRoomInviteResponse struct {
+	Message string `json:"message"`
+}
+
+type RevokeRoomInviteHandler struct {
+	usecase httpUsecase.RevokeRoomInviteUseCase
+}
+
+func NewRevokeRoomInviteHandler(usecase httpUsecase.RevokeRoomInviteUseCase) *RevokeRoomInviteHandler {
+	return &RevokeRoomInviteHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *RevokeRoomInviteHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *RevokeRoomInviteRequest) (*RevokeRoomInviteResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, err := h.usecase.Execute(ctx, req.RoomID, userID, req.InviteID)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &RevokeRoomInviteResponse{Message: "Invite revoked"}, status, nil
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type SetPlayerRoleRequest struct {
+	RoomID uuid.UUID       `params:"room_id"`
+	UserID uuid.UUID       `json:"user_id"`
+	Role   domain.RoomRole `json:"role" validate:"required,oneof=co_host player"`
+}
+
+type SetPlayerRoleResponse struct {
+	Message string `json:"message"`
+}
+
+type SetPlayerRoleHandler struct {
+	usecase httpUsecase.SetPlayerRoleUseCase
+}
+
+func NewSetPlayerRoleHandler(usecase httpUsecase.SetPlayerRoleUseCase) *SetPlayerRoleHandler {
+	return &SetPlayerRoleHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *SetPlayerRoleHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *SetPlayerRoleRequest) (*SetPlayerRoleResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, err := h.usecase.Execute(ctx, req.RoomID, userID, req.UserID, req.Role)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &SetPlayerRoleResponse{Message: "Player role updated"}, status, nil
+}
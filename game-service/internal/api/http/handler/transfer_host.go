@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type TransferHostRequest struct {
+	RoomID uuid.UUID `params:"room_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type TransferHostResponse struct {
+	Message string `json:"message"`
+}
+
+type TransferHostHandler struct {
+	usecase httpUsecase.TransferHostUseCase
+}
+
+func NewTransferHostHandler(usecase httpUsecase.TransferHostUseCase) *TransferHostHandler {
+	return &TransferHostHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *TransferHostHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *TransferHostRequest) (*TransferHostResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, err := h.usecase.Execute(ctx, req.RoomID, userID, req.UserID)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &TransferHostResponse{Message: "Host transferred"}, status, nil
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type UnbanPlayerRequest struct {
+	RoomID uuid.UUID `params:"room_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type UnbanPlayerResponse struct {
+	Message string `json:"message"`
+}
+
+type UnbanPlayerHandler struct {
+	usecase httpUsecase.UnbanPlayerUseCase
+}
+
+func NewUnbanPlayerHandler(usecase httpUsecase.UnbanPlayerUseCase) *UnbanPlayerHandler {
+	return &UnbanPlayerHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *UnbanPlayerHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *UnbanPlayerRequest) (*UnbanPlayerResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, err := h.usecase.Execute(ctx, req.RoomID, userID, req.UserID)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &UnbanPlayerResponse{Message: "Player unbanned"}, status, nil
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	httpUsecase "game-service/internal/api/http/usecase"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// UpdateRoomSettingsRequest, kısmi güncellemedir; gönderilmeyen alanlar değişmez.
+type UpdateRoomSettingsRequest struct {
+	RoomID uuid.UUID `params:"room_id"`
+	domain.RoomSettingsPatch
+}
+
+type UpdateRoomSettingsResponse struct {
+	Message  string              `json:"message"`
+	Settings domain.RoomSettings `json:"settings"`
+}
+
+type UpdateRoomSettingsHandler struct {
+	usecase httpUsecase.UpdateRoomSettingsUseCase
+}
+
+func NewUpdateRoomSettingsHandler(usecase httpUsecase.UpdateRoomSettingsUseCase) *UpdateRoomSettingsHandler {
+	return &UpdateRoomSettingsHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *UpdateRoomSettingsHandler) Handle(fbrCtx *fiber.Ctx, ctx context.Context, req *UpdateRoomSettingsRequest) (*UpdateRoomSettingsResponse, int, error) {
+	userIDStr := fbrCtx.Get("X-User-ID")
+
+	if userIDStr == "" {
+
+		return nil, fiber.StatusUnauthorized, domain.ErrUnauthorized
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, fiber.StatusBadRequest, fmt.Errorf("Invalid user ID format")
+
+	}
+
+	status, settings, err := h.usecase.Execute(ctx, req.RoomID, userID, req.RoomSettingsPatch)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &UpdateRoomSettingsResponse{Message: "Room settings updated", Settings: settings}, status, nil
+}
@@ -5,39 +5,50 @@ import (
 	"errors"
 	"fmt"
 	"game-service/domain"
+	"game-service/infra/redis"
 	"net/http"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 )
 
 type CreateRoomUseCase interface {
-	Execute(ctx context.Context, data domain.Room) (int, error)
+	Execute(ctx context.Context, data domain.Room) (int, uuid.UUID, string, error)
 }
 
 type createRoomUseCase struct {
-	repository PostgresRepository
+	repository    PostgresRepository
+	roomRedisRepo RoomRedisRepository
+	gameHub       GameHub
 }
 
-func NewCreateRoomUseCase(repository PostgresRepository) CreateRoomUseCase {
+func NewCreateRoomUseCase(repository PostgresRepository, roomRedisRepo RoomRedisRepository, gameHub GameHub) CreateRoomUseCase {
 	return &createRoomUseCase{
-		repository: repository,
+		repository:    repository,
+		roomRedisRepo: roomRedisRepo,
+		gameHub:       gameHub,
 	}
 }
 
-func (u *createRoomUseCase) Execute(ctx context.Context, data domain.Room) (int, error) {
-	roomID, err := u.repository.CreateRoom(ctx, data.RoomName, data.CreatorID, data.MaxPlayers, data.GameModeID, data.IsPrivate, data.RoomCode)
+func (u *createRoomUseCase) Execute(ctx context.Context, data domain.Room) (int, uuid.UUID, string, error) {
+	// Sunucuda motoru olmayan modda oda açılırsa oyun başlatılamaz
+	if !u.gameHub.SupportsGameMode(data.GameModeID) {
+		return http.StatusBadRequest, uuid.Nil, "", unsupportedGameModeError(data.GameModeID)
+	}
+	roomID, roomCode, err := u.repository.CreateRoom(ctx, data.RoomName, data.CreatorID, data.MaxPlayers, data.GameModeID, data.IsPrivate)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrInvalidInput):
-			return http.StatusBadRequest, err
+			return http.StatusBadRequest, uuid.Nil, "", err
 
 		case errors.Is(err, domain.ErrConflict):
-			return http.StatusConflict, err
+			return http.StatusConflict, uuid.Nil, "", err
 
 		default:
-			return http.StatusInternalServerError, err
+			return http.StatusInternalServerError, uuid.Nil, "", err
 		}
 	}
 	fmt.Println(roomID)
-	return fiber.StatusCreated, nil
+	go publishLobbyRoom(u.repository, u.roomRedisRepo, roomID, redis.MSG_ROOM_CREATED)
+	return fiber.StatusCreated, roomID, roomCode, nil
 }
@@ -0,0 +1,50 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CreateRoomInviteUseCase interface {
+	Execute(ctx context.Context, roomID, userID uuid.UUID, expiresInMinutes, maxUses int) (int, *domain.RoomInvite, error)
+}
+
+type createRoomInviteUseCase struct {
+	repository PostgresRepository
+}
+
+func NewCreateRoomInviteUseCase(repository PostgresRepository) CreateRoomInviteUseCase {
+	return &createRoomInviteUseCase{
+		repository: repository,
+	}
+}
+
+func (u *createRoomInviteUseCase) Execute(ctx context.Context, roomID, userID uuid.UUID, expiresInMinutes, maxUses int) (int, *domain.RoomInvite, error) {
+	invite, err := u.repository.CreateRoomInvite(ctx, roomID, userID, time.Duration(expiresInMinutes)*time.Minute, maxUses)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return http.StatusBadRequest, nil, err
+
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, nil, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, nil, err
+
+		case errors.Is(err, domain.ErrConflict):
+			return http.StatusConflict, nil, err
+
+		default:
+			return http.StatusInternalServerError, nil, err
+		}
+	}
+
+	return fiber.StatusCreated, &invite, nil
+}
@@ -0,0 +1,46 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type GetBannedPlayersUseCase interface {
+	Execute(ctx context.Context, roomID, hostID uuid.UUID) (int, []domain.BannedPlayer, error)
+}
+
+type getBannedPlayersUseCase struct {
+	repository PostgresRepository
+}
+
+func NewGetBannedPlayersUseCase(repository PostgresRepository) GetBannedPlayersUseCase {
+	return &getBannedPlayersUseCase{
+		repository: repository,
+	}
+}
+
+func (u *getBannedPlayersUseCase) Execute(ctx context.Context, roomID, hostID uuid.UUID) (int, []domain.BannedPlayer, error) {
+	players, err := u.repository.GetBannedPlayers(ctx, roomID, hostID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return http.StatusBadRequest, nil, err
+
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, nil, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, nil, err
+
+		default:
+			return http.StatusInternalServerError, nil, err
+		}
+	}
+
+	return fiber.StatusOK, players, nil
+}
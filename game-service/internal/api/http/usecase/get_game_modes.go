@@ -0,0 +1,45 @@
+package httpUsecase
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GetGameModesUseCase interface {
+	Execute(ctx context.Context) (int, []domain.GameMode, error)
+}
+
+type getGameModesUseCase struct {
+	repository PostgresRepository
+	gameHub    GameHub
+}
+
+func NewGetGameModesUseCase(repository PostgresRepository, gameHub GameHub) GetGameModesUseCase {
+	return &getGameModesUseCase{
+		repository: repository,
+		gameHub:    gameHub,
+	}
+}
+
+// unsupportedGameModeError, katalogda olup sunucuda motoru olmayan modlar için döner.
+func unsupportedGameModeError(gameModeID int) error {
+	return fmt.Errorf("%w: game mode %d is not playable on this server", domain.ErrInvalidInput, gameModeID)
+}
+
+func (u *getGameModesUseCase) Execute(ctx context.Context) (int, []domain.GameMode, error) {
+	modes, err := u.repository.GetGameModes(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, nil, err
+	}
+
+	// Katalogda olup sunucuda motoru olmayan modlar seçilemez olarak işaretlenir
+	for i := range modes {
+		modes[i].Available = u.gameHub.SupportsGameMode(modes[i].ID)
+	}
+
+	return fiber.StatusOK, modes, nil
+}
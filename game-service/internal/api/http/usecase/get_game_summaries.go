@@ -0,0 +1,70 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// Oyun özeti listesinde varsayılan kayıt sayısı
+const defaultGameSummaryLimit = 10
+
+type GetGameSummariesUseCase interface {
+	Execute(ctx context.Context, roomID, userID uuid.UUID, limit int) (int, []domain.GameSummary, error)
+}
+
+type getGameSummariesUseCase struct {
+	repository PostgresRepository
+}
+
+func NewGetGameSummariesUseCase(repository PostgresRepository) GetGameSummariesUseCase {
+	return &getGameSummariesUseCase{
+		repository: repository,
+	}
+}
+
+func (u *getGameSummariesUseCase) Execute(ctx context.Context, roomID, userID uuid.UUID, limit int) (int, []domain.GameSummary, error) {
+	if limit <= 0 {
+		limit = defaultGameSummaryLimit
+	}
+
+	summaries, err := u.getSummaries(ctx, roomID, userID, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, nil, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, nil, err
+
+		default:
+			return http.StatusInternalServerError, nil, err
+		}
+	}
+
+	return fiber.StatusOK, summaries, nil
+}
+
+// getSummaries, gizli odaların oyun geçmişini sadece üyelerine gösterir.
+func (u *getGameSummariesUseCase) getSummaries(ctx context.Context, roomID, userID uuid.UUID, limit int) ([]domain.GameSummary, error) {
+	room, err := u.repository.GetRoomSummary(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if room.IsPrivate {
+		role, err := u.repository.GetRoomRole(ctx, roomID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if role == domain.RoleSpectator {
+			// Gizli odanın varlığı üye olmayanlara belli edilmez
+			return nil, domain.ErrNotFound
+		}
+	}
+
+	return u.repository.GetGameSummaries(ctx, roomID, limit)
+}
@@ -0,0 +1,49 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type GetRoomInvitesUseCase interface {
+	Execute(ctx context.Context, roomID, userID uuid.UUID) (int, []domain.RoomInvite, error)
+}
+
+type getRoomInvitesUseCase struct {
+	repository PostgresRepository
+}
+
+func NewGetRoomInvitesUseCase(repository PostgresRepository) GetRoomInvitesUseCase {
+	return &getRoomInvitesUseCase{
+		repository: repository,
+	}
+}
+
+func (u *getRoomInvitesUseCase) Execute(ctx context.Context, roomID, userID uuid.UUID) (int, []domain.RoomInvite, error) {
+	invites, err := u.repository.GetRoomInvites(ctx, roomID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return http.StatusBadRequest, nil, err
+
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, nil, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, nil, err
+
+		case errors.Is(err, domain.ErrConflict):
+			return http.StatusConflict, nil, err
+
+		default:
+			return http.StatusInternalServerError, nil, err
+		}
+	}
+
+	return fiber.StatusOK, invites, nil
+}
@@ -0,0 +1,63 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type GetRoomSettingsUseCase interface {
+	Execute(ctx context.Context, roomID, userID uuid.UUID) (int, domain.RoomSettings, error)
+}
+
+type getRoomSettingsUseCase struct {
+	repository PostgresRepository
+}
+
+func NewGetRoomSettingsUseCase(repository PostgresRepository) GetRoomSettingsUseCase {
+	return &getRoomSettingsUseCase{
+		repository: repository,
+	}
+}
+
+func (u *getRoomSettingsUseCase) Execute(ctx context.Context, roomID, userID uuid.UUID) (int, domain.RoomSettings, error) {
+	settings, err := u.getSettings(ctx, roomID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, domain.RoomSettings{}, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, domain.RoomSettings{}, err
+
+		default:
+			return http.StatusInternalServerError, domain.RoomSettings{}, err
+		}
+	}
+
+	return fiber.StatusOK, settings, nil
+}
+
+// getSettings, gizli odaların ayarlarını sadece üyelerine gösterir.
+func (u *getRoomSettingsUseCase) getSettings(ctx context.Context, roomID, userID uuid.UUID) (domain.RoomSettings, error) {
+	room, err := u.repository.GetRoomSummary(ctx, roomID)
+	if err != nil {
+		return domain.RoomSettings{}, err
+	}
+	if room.IsPrivate {
+		role, err := u.repository.GetRoomRole(ctx, roomID, userID)
+		if err != nil {
+			return domain.RoomSettings{}, err
+		}
+		if role == domain.RoleSpectator {
+			// Gizli odanın varlığı üye olmayanlara belli edilmez
+			return domain.RoomSettings{}, domain.ErrNotFound
+		}
+	}
+
+	return u.repository.GetRoomSettings(ctx, roomID)
+}
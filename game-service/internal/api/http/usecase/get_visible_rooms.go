@@ -11,7 +11,7 @@ import (
 )
 
 type GetVisibleRoomsUseCase interface {
-	Execute(ctx context.Context, userID uuid.UUID) (int, []domain.Room, error)
+	Execute(ctx context.Context, userID uuid.UUID, filter domain.RoomListFilter) (int, []domain.Room, string, error)
 }
 
 type getVisibleRoomsUseCase struct {
@@ -24,20 +24,20 @@ func NewGetVisibleRoomsUseCase(repository PostgresRepository) GetVisibleRoomsUse
 	}
 }
 
-func (u *getVisibleRoomsUseCase) Execute(ctx context.Context, userID uuid.UUID) (int, []domain.Room, error) {
-	rooms, err := u.repository.GetVisibleRooms(ctx, userID)
+func (u *getVisibleRoomsUseCase) Execute(ctx context.Context, userID uuid.UUID, filter domain.RoomListFilter) (int, []domain.Room, string, error) {
+	rooms, nextCursor, err := u.repository.GetVisibleRooms(ctx, userID, filter)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrInvalidInput):
-			return http.StatusBadRequest, nil, err
+			return http.StatusBadRequest, nil, "", err
 
 		case errors.Is(err, domain.ErrConflict):
-			return http.StatusConflict, nil, err
+			return http.StatusConflict, nil, "", err
 
 		default:
-			return http.StatusInternalServerError, nil, err
+			return http.StatusInternalServerError, nil, "", err
 		}
 	}
 
-	return fiber.StatusCreated, rooms, nil
+	return fiber.StatusCreated, rooms, nextCursor, nil
 }
@@ -3,18 +3,48 @@ package httpUsecase
 import (
 	"context"
 	"game-service/domain"
+	"game-service/internal/api/ws/hub"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type PostgresRepository interface {
 	CreateUser(ctx context.Context, userID uuid.UUID, username, email string) error
-	CreateRoom(ctx context.Context, roomName string, creatorID uuid.UUID, maxPlayers int, gameModeID int, isPrivate bool, roomCode string) (uuid.UUID, error)
-	JoinRoom(ctx context.Context, roomID, userID uuid.UUID, roomCode string) error
-	LeaveRoom(ctx context.Context, roomID, userID uuid.UUID) error
-	UpdateRoomGameMode(ctx context.Context, roomID uuid.UUID, userID uuid.UUID, newGameModeID int) error
-	GetVisibleRooms(ctx context.Context, userID uuid.UUID) ([]domain.Room, error)
+	CreateRoom(ctx context.Context, roomName string, creatorID uuid.UUID, maxPlayers int, gameModeID int, isPrivate bool) (uuid.UUID, string, error)
+	JoinRoom(ctx context.Context, roomID, userID uuid.UUID, roomCode string, allowLateJoin bool) error
+	LeaveRoom(ctx context.Context, roomID, userID uuid.UUID) (uuid.UUID, error)
+	UpdateRoomGameMode(ctx context.Context, roomID uuid.UUID, userID uuid.UUID, newGameModeID int) (domain.GameMode, error)
+	GetVisibleRooms(ctx context.Context, userID uuid.UUID, filter domain.RoomListFilter) ([]domain.Room, string, error)
+	KickPlayer(ctx context.Context, roomID, hostID, targetID uuid.UUID) error
+	BanPlayer(ctx context.Context, roomID, hostID, targetID uuid.UUID) error
+	UnbanPlayer(ctx context.Context, roomID, hostID, targetID uuid.UUID) error
+	GetBannedPlayers(ctx context.Context, roomID, hostID uuid.UUID) ([]domain.BannedPlayer, error)
+	SetPlayerRole(ctx context.Context, roomID, actorID, targetID uuid.UUID, role domain.RoomRole) error
+	TransferHost(ctx context.Context, roomID, hostID, targetID uuid.UUID) error
+	QuickPlay(ctx context.Context, userID uuid.UUID, gameModeID int, languageCode string) (uuid.UUID, bool, error)
+	GetRoomIDByCode(ctx context.Context, roomCode string) (uuid.UUID, error)
+	GetRoomSummary(ctx context.Context, roomID uuid.UUID) (domain.Room, error)
+	RegenerateRoomCode(ctx context.Context, roomID, actorID uuid.UUID) (string, error)
+	CreateRoomInvite(ctx context.Context, roomID, actorID uuid.UUID, ttl time.Duration, maxUses int) (domain.RoomInvite, error)
+	GetRoomInvites(ctx context.Context, roomID, actorID uuid.UUID) ([]domain.RoomInvite, error)
+	RevokeRoomInvite(ctx context.Context, roomID, actorID, inviteID uuid.UUID) error
+	GetInviteRoomID(ctx context.Context, token string) (uuid.UUID, error)
+	JoinRoomWithInvite(ctx context.Context, token string, userID uuid.UUID, allowLateJoin bool) (uuid.UUID, error)
+	GetRoomRole(ctx context.Context, roomID, userID uuid.UUID) (domain.RoomRole, error)
+	GetRoomSettings(ctx context.Context, roomID uuid.UUID) (domain.RoomSettings, error)
+	GetGameModes(ctx context.Context) ([]domain.GameMode, error)
+	GetGameMode(ctx context.Context, gameModeID int) (domain.GameMode, error)
+	UpdateRoomSettings(ctx context.Context, roomID, actorID uuid.UUID, patch domain.RoomSettingsPatch) (domain.RoomSettings, error)
+	GetGameSummaries(ctx context.Context, roomID uuid.UUID, limit int) ([]domain.GameSummary, error)
 }
 type RoomRedisRepository interface {
 	PublishMessage(ctx context.Context, roomID uuid.UUID, msgType string, dataContent interface{})
+	PublishLobbyEvent(ctx context.Context, msgType string, roomID uuid.UUID, data interface{})
+	IncrementAttempts(ctx context.Context, key string, window time.Duration) (int64, error)
+	GetAttempts(ctx context.Context, key string) (int64, error)
+}
+type GameHub interface {
+	GetRoomSettings(roomID uuid.UUID) *hub.GameSettings
+	SupportsGameMode(gameModeID int) bool
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"game-service/domain"
+	"game-service/infra/redis"
 	"net/http"
 
 	"github.com/gofiber/fiber/v2"
@@ -18,22 +19,33 @@ type JoinRoomUseCase interface {
 type joinRoomUseCase struct {
 	repository    PostgresRepository
 	roomRedisRepo RoomRedisRepository
+	gameHub       GameHub
 }
 
-func NewJoinRoomUseCase(repository PostgresRepository, roomRedisRepo RoomRedisRepository) JoinRoomUseCase {
+func NewJoinRoomUseCase(repository PostgresRepository, roomRedisRepo RoomRedisRepository, gameHub GameHub) JoinRoomUseCase {
 	return &joinRoomUseCase{
 		repository:    repository,
 		roomRedisRepo: roomRedisRepo,
+		gameHub:       gameHub,
 	}
 }
 
 func (u *joinRoomUseCase) Execute(ctx context.Context, roomID, userID uuid.UUID, roomCode string) (int, error) {
-	err := u.repository.JoinRoom(ctx, roomID, userID, roomCode)
+	settings := u.gameHub.GetRoomSettings(roomID)
+	allowLateJoin := settings != nil && settings.AllowLateJoin
+
+	err := u.repository.JoinRoom(ctx, roomID, userID, roomCode, allowLateJoin)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrInvalidInput):
 			return http.StatusBadRequest, err
 
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, err
+
 		case errors.Is(err, domain.ErrConflict):
 			return http.StatusConflict, err
 
@@ -42,6 +54,7 @@ func (u *joinRoomUseCase) Execute(ctx context.Context, roomID, userID uuid.UUID,
 		}
 	}
 	go u.roomRedisRepo.PublishMessage(ctx, roomID, "player_joined", map[string]string{"user_id": userID.String()})
+	go publishLobbyRoom(u.repository, u.roomRedisRepo, roomID, redis.MSG_ROOM_UPDATED)
 	fmt.Println(roomID)
 	return fiber.StatusCreated, nil
 }
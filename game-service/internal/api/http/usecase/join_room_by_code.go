@@ -0,0 +1,93 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"game-service/domain"
+	"game-service/infra/redis"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// Kod tahminini (brute-force) zorlaştırmak için kullanıcı başına hatalı deneme sınırı.
+const (
+	maxJoinCodeAttempts   = 10
+	joinCodeAttemptWindow = 15 * time.Minute
+)
+
+type JoinRoomByCodeUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID, roomCode string) (int, uuid.UUID, error)
+}
+
+type joinRoomByCodeUseCase struct {
+	repository    PostgresRepository
+	roomRedisRepo RoomRedisRepository
+	gameHub       GameHub
+}
+
+func NewJoinRoomByCodeUseCase(repository PostgresRepository, roomRedisRepo RoomRedisRepository, gameHub GameHub) JoinRoomByCodeUseCase {
+	return &joinRoomByCodeUseCase{
+		repository:    repository,
+		roomRedisRepo: roomRedisRepo,
+		gameHub:       gameHub,
+	}
+}
+
+func joinCodeAttemptsKey(userID uuid.UUID) string {
+	return fmt.Sprintf("join_code_attempts:%s", userID)
+}
+
+func (u *joinRoomByCodeUseCase) Execute(ctx context.Context, userID uuid.UUID, roomCode string) (int, uuid.UUID, error) {
+	attemptsKey := joinCodeAttemptsKey(userID)
+	attempts, err := u.roomRedisRepo.GetAttempts(ctx, attemptsKey)
+	if err != nil {
+		// Redis hatası katılımı engellemesin
+		log.Printf("Failed to read join code attempts for %s: %v", userID, err)
+	}
+	if attempts >= maxJoinCodeAttempts {
+		return http.StatusTooManyRequests, uuid.Nil, fmt.Errorf("%w: too many invalid room codes, try again later", domain.ErrTooManyRequests)
+	}
+
+	roomID, err := u.repository.GetRoomIDByCode(ctx, roomCode)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			if _, incErr := u.roomRedisRepo.IncrementAttempts(ctx, attemptsKey, joinCodeAttemptWindow); incErr != nil {
+				log.Printf("Failed to record join code attempt for %s: %v", userID, incErr)
+			}
+			return http.StatusNotFound, uuid.Nil, err
+		}
+		return http.StatusInternalServerError, uuid.Nil, err
+	}
+
+	settings := u.gameHub.GetRoomSettings(roomID)
+	allowLateJoin := settings != nil && settings.AllowLateJoin
+
+	err = u.repository.JoinRoom(ctx, roomID, userID, roomCode, allowLateJoin)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return http.StatusBadRequest, uuid.Nil, err
+
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, uuid.Nil, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, uuid.Nil, err
+
+		case errors.Is(err, domain.ErrConflict):
+			return http.StatusConflict, uuid.Nil, err
+
+		default:
+			return http.StatusInternalServerError, uuid.Nil, err
+		}
+	}
+	go u.roomRedisRepo.PublishMessage(ctx, roomID, "player_joined", map[string]string{"user_id": userID.String()})
+	go publishLobbyRoom(u.repository, u.roomRedisRepo, roomID, redis.MSG_ROOM_UPDATED)
+
+	return fiber.StatusCreated, roomID, nil
+}
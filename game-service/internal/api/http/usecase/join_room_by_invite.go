@@ -0,0 +1,68 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"game-service/infra/redis"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type JoinRoomByInviteUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID, token string) (int, uuid.UUID, error)
+}
+
+type joinRoomByInviteUseCase struct {
+	repository    PostgresRepository
+	roomRedisRepo RoomRedisRepository
+	gameHub       GameHub
+}
+
+func NewJoinRoomByInviteUseCase(repository PostgresRepository, roomRedisRepo RoomRedisRepository, gameHub GameHub) JoinRoomByInviteUseCase {
+	return &joinRoomByInviteUseCase{
+		repository:    repository,
+		roomRedisRepo: roomRedisRepo,
+		gameHub:       gameHub,
+	}
+}
+
+func (u *joinRoomByInviteUseCase) Execute(ctx context.Context, userID uuid.UUID, token string) (int, uuid.UUID, error) {
+	// Geç katılım ayarı odaya bağlı olduğundan önce davetin odası bulunur
+	roomID, err := u.repository.GetInviteRoomID(ctx, token)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return http.StatusNotFound, uuid.Nil, err
+		}
+		return http.StatusInternalServerError, uuid.Nil, err
+	}
+
+	settings := u.gameHub.GetRoomSettings(roomID)
+	allowLateJoin := settings != nil && settings.AllowLateJoin
+
+	roomID, err = u.repository.JoinRoomWithInvite(ctx, token, userID, allowLateJoin)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return http.StatusBadRequest, uuid.Nil, err
+
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, uuid.Nil, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, uuid.Nil, err
+
+		case errors.Is(err, domain.ErrConflict):
+			return http.StatusConflict, uuid.Nil, err
+
+		default:
+			return http.StatusInternalServerError, uuid.Nil, err
+		}
+	}
+	go u.roomRedisRepo.PublishMessage(ctx, roomID, "player_joined", map[string]string{"user_id": userID.String()})
+	go publishLobbyRoom(u.repository, u.roomRedisRepo, roomID, redis.MSG_ROOM_UPDATED)
+
+	return fiber.StatusCreated, roomID, nil
+}
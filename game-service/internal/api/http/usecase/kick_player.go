@@ -0,0 +1,54 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"game-service/infra/redis"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type KickPlayerUseCase interface {
+	Execute(ctx context.Context, roomID, hostID, targetID uuid.UUID) (int, error)
+}
+
+type kickPlayerUseCase struct {
+	repository    PostgresRepository
+	roomRedisRepo RoomRedisRepository
+}
+
+func NewKickPlayerUseCase(repository PostgresRepository, roomRedisRepo RoomRedisRepository) KickPlayerUseCase {
+	return &kickPlayerUseCase{
+		repository:    repository,
+		roomRedisRepo: roomRedisRepo,
+	}
+}
+
+func (u *kickPlayerUseCase) Execute(ctx context.Context, roomID, hostID, targetID uuid.UUID) (int, error) {
+	err := u.repository.KickPlayer(ctx, roomID, hostID, targetID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return http.StatusBadRequest, err
+
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, err
+
+		case errors.Is(err, domain.ErrConflict):
+			return http.StatusConflict, err
+
+		default:
+			return http.StatusInternalServerError, err
+		}
+	}
+	go u.roomRedisRepo.PublishMessage(ctx, roomID, "player_kicked", map[string]string{"user_id": targetID.String(), "by": hostID.String()})
+	go publishLobbyRoom(u.repository, u.roomRedisRepo, roomID, redis.MSG_ROOM_UPDATED)
+
+	return fiber.StatusOK, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"game-service/domain"
+	"game-service/infra/redis"
 	"net/http"
 
 	"github.com/gofiber/fiber/v2"
@@ -28,12 +29,15 @@ func NewLeaveRoomUseCase(repository PostgresRepository, roomRedisRepo RoomRedisR
 }
 
 func (u *leaveRoomUseCase) Execute(ctx context.Context, roomID, userID uuid.UUID) (int, error) {
-	err := u.repository.LeaveRoom(ctx, roomID, userID)
+	newHostID, err := u.repository.LeaveRoom(ctx, roomID, userID)
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrInvalidInput):
 			return http.StatusBadRequest, err
 
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, err
+
 		case errors.Is(err, domain.ErrConflict):
 			return http.StatusConflict, err
 
@@ -43,6 +47,15 @@ func (u *leaveRoomUseCase) Execute(ctx context.Context, roomID, userID uuid.UUID
 	}
 	fmt.Println(roomID)
 	go u.roomRedisRepo.PublishMessage(ctx, roomID, "player_left", map[string]string{"user_id": userID.String()})
+	go publishLobbyRoom(u.repository, u.roomRedisRepo, roomID, redis.MSG_ROOM_UPDATED)
+	if newHostID != uuid.Nil {
+		// Host ayrıldı: bağlı client'lar yeni host'u öğrenmeli
+		go u.roomRedisRepo.PublishMessage(ctx, roomID, "host_changed", map[string]string{
+			"old_host_id": userID.String(),
+			"new_host_id": newHostID.String(),
+			"reason":      "host_left",
+		})
+	}
 
 	return fiber.StatusOK, nil
 }
@@ -0,0 +1,37 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"game-service/infra/redis"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const lobbyPublishTimeout = 5 * time.Second
+
+// publishLobbyRoom, odanın güncel halini lobi akışına yayınlar. Oda artık yoksa silindi olayı gönderilir.
+// Gizli odalar lobi listesinde herkese görünmediğinden akışa eklenmez.
+// İstek tamamlandıktan sonra çalışabileceği için kendi context'ini kullanır.
+func publishLobbyRoom(repository PostgresRepository, roomRedisRepo RoomRedisRepository, roomID uuid.UUID, msgType string) {
+	ctx, cancel := context.WithTimeout(context.Background(), lobbyPublishTimeout)
+	defer cancel()
+
+	room, err := repository.GetRoomSummary(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			roomRedisRepo.PublishLobbyEvent(ctx, redis.MSG_ROOM_DELETED, roomID, map[string]string{"room_id": roomID.String()})
+			return
+		}
+		log.Printf("Failed to load room %s for lobby feed: %v", roomID, err)
+		return
+	}
+	if room.IsPrivate {
+		return
+	}
+
+	roomRedisRepo.PublishLobbyEvent(ctx, msgType, roomID, room)
+}
@@ -0,0 +1,59 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"game-service/infra/redis"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type QuickPlayUseCase interface {
+	Execute(ctx context.Context, userID uuid.UUID, gameModeID int, languageCode string) (int, uuid.UUID, bool, error)
+}
+
+type quickPlayUseCase struct {
+	repository    PostgresRepository
+	roomRedisRepo RoomRedisRepository
+	gameHub       GameHub
+}
+
+func NewQuickPlayUseCase(repository PostgresRepository, roomRedisRepo RoomRedisRepository, gameHub GameHub) QuickPlayUseCase {
+	return &quickPlayUseCase{
+		repository:    repository,
+		roomRedisRepo: roomRedisRepo,
+		gameHub:       gameHub,
+	}
+}
+
+func (u *quickPlayUseCase) Execute(ctx context.Context, userID uuid.UUID, gameModeID int, languageCode string) (int, uuid.UUID, bool, error) {
+	// 0 herhangi bir mod demektir; belirli bir mod istendiyse oynanabilir olmalı (yeni oda açılabilir)
+	if gameModeID != 0 && !u.gameHub.SupportsGameMode(gameModeID) {
+		return http.StatusBadRequest, uuid.Nil, false, unsupportedGameModeError(gameModeID)
+	}
+	roomID, created, err := u.repository.QuickPlay(ctx, userID, gameModeID, languageCode)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return http.StatusBadRequest, uuid.Nil, false, err
+
+		case errors.Is(err, domain.ErrConflict):
+			return http.StatusConflict, uuid.Nil, false, err
+
+		default:
+			return http.StatusInternalServerError, uuid.Nil, false, err
+		}
+	}
+
+	if created {
+		go publishLobbyRoom(u.repository, u.roomRedisRepo, roomID, redis.MSG_ROOM_CREATED)
+		return fiber.StatusCreated, roomID, true, nil
+	}
+	go u.roomRedisRepo.PublishMessage(ctx, roomID, "player_joined", map[string]string{"user_id": userID.String()})
+	go publishLobbyRoom(u.repository, u.roomRedisRepo, roomID, redis.MSG_ROOM_UPDATED)
+
+	return fiber.StatusOK, roomID, false, nil
+}
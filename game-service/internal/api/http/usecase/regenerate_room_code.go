@@ -0,0 +1,53 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type RegenerateRoomCodeUseCase interface {
+	Execute(ctx context.Context, roomID, userID uuid.UUID) (int, string, error)
+}
+
+type regenerateRoomCodeUseCase struct {
+	repository    PostgresRepository
+	roomRedisRepo RoomRedisRepository
+}
+
+func NewRegenerateRoomCodeUseCase(repository PostgresRepository, roomRedisRepo RoomRedisRepository) RegenerateRoomCodeUseCase {
+	return &regenerateRoomCodeUseCase{
+		repository:    repository,
+		roomRedisRepo: roomRedisRepo,
+	}
+}
+
+func (u *regenerateRoomCodeUseCase) Execute(ctx context.Context, roomID, userID uuid.UUID) (int, string, error) {
+	roomCode, err := u.repository.RegenerateRoomCode(ctx, roomID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return http.StatusBadRequest, "", err
+
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, "", err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, "", err
+
+		case errors.Is(err, domain.ErrConflict):
+			return http.StatusConflict, "", err
+
+		default:
+			return http.StatusInternalServerError, "", err
+		}
+	}
+	// Kodun kendisi yayınlanmaz; odadakiler yeni kodu oda listesinden alabilir.
+	go u.roomRedisRepo.PublishMessage(ctx, roomID, "room_code_changed", map[string]string{"by": userID.String()})
+
+	return fiber.StatusOK, roomCode, nil
+}
@@ -0,0 +1,49 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type RevokeRoomInviteUseCase interface {
+	Execute(ctx context.Context, roomID, userID, inviteID uuid.UUID) (int, error)
+}
+
+type revokeRoomInviteUseCase struct {
+	repository PostgresRepository
+}
+
+func NewRevokeRoomInviteUseCase(repository PostgresRepository) RevokeRoomInviteUseCase {
+	return &revokeRoomInviteUseCase{
+		repository: repository,
+	}
+}
+
+func (u *revokeRoomInviteUseCase) Execute(ctx context.Context, roomID, userID, inviteID uuid.UUID) (int, error) {
+	err := u.repository.RevokeRoomInvite(ctx, roomID, userID, inviteID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return http.StatusBadRequest, err
+
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, err
+
+		case errors.Is(err, domain.ErrConflict):
+			return http.StatusConflict, err
+
+		default:
+			return http.StatusInternalServerError, err
+		}
+	}
+
+	return fiber.StatusOK, nil
+}
@@ -0,0 +1,49 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type SetPlayerRoleUseCase interface {
+	Execute(ctx context.Context, roomID, actorID, targetID uuid.UUID, role domain.RoomRole) (int, error)
+}
+
+type setPlayerRoleUseCase struct {
+	repository    PostgresRepository
+	roomRedisRepo RoomRedisRepository
+}
+
+func NewSetPlayerRoleUseCase(repository PostgresRepository, roomRedisRepo RoomRedisRepository) SetPlayerRoleUseCase {
+	return &setPlayerRoleUseCase{
+		repository:    repository,
+		roomRedisRepo: roomRedisRepo,
+	}
+}
+
+func (u *setPlayerRoleUseCase) Execute(ctx context.Context, roomID, actorID, targetID uuid.UUID, role domain.RoomRole) (int, error) {
+	err := u.repository.SetPlayerRole(ctx, roomID, actorID, targetID, role)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return http.StatusBadRequest, err
+
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, err
+
+		default:
+			return http.StatusInternalServerError, err
+		}
+	}
+	go u.roomRedisRepo.PublishMessage(ctx, roomID, "role_changed", map[string]string{"user_id": targetID.String(), "role": string(role), "by": actorID.String()})
+
+	return fiber.StatusOK, nil
+}
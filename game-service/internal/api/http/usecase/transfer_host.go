@@ -0,0 +1,53 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type TransferHostUseCase interface {
+	Execute(ctx context.Context, roomID, hostID, targetID uuid.UUID) (int, error)
+}
+
+type transferHostUseCase struct {
+	repository    PostgresRepository
+	roomRedisRepo RoomRedisRepository
+}
+
+func NewTransferHostUseCase(repository PostgresRepository, roomRedisRepo RoomRedisRepository) TransferHostUseCase {
+	return &transferHostUseCase{
+		repository:    repository,
+		roomRedisRepo: roomRedisRepo,
+	}
+}
+
+func (u *transferHostUseCase) Execute(ctx context.Context, roomID, hostID, targetID uuid.UUID) (int, error) {
+	err := u.repository.TransferHost(ctx, roomID, hostID, targetID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return http.StatusBadRequest, err
+
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, err
+
+		default:
+			return http.StatusInternalServerError, err
+		}
+	}
+	go u.roomRedisRepo.PublishMessage(ctx, roomID, "host_changed", map[string]string{
+		"old_host_id": hostID.String(),
+		"new_host_id": targetID.String(),
+		"reason":      "transfer",
+	})
+
+	return fiber.StatusOK, nil
+}
@@ -0,0 +1,52 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type UnbanPlayerUseCase interface {
+	Execute(ctx context.Context, roomID, hostID, targetID uuid.UUID) (int, error)
+}
+
+type unbanPlayerUseCase struct {
+	repository    PostgresRepository
+	roomRedisRepo RoomRedisRepository
+}
+
+func NewUnbanPlayerUseCase(repository PostgresRepository, roomRedisRepo RoomRedisRepository) UnbanPlayerUseCase {
+	return &unbanPlayerUseCase{
+		repository:    repository,
+		roomRedisRepo: roomRedisRepo,
+	}
+}
+
+func (u *unbanPlayerUseCase) Execute(ctx context.Context, roomID, hostID, targetID uuid.UUID) (int, error) {
+	err := u.repository.UnbanPlayer(ctx, roomID, hostID, targetID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return http.StatusBadRequest, err
+
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, err
+
+		case errors.Is(err, domain.ErrConflict):
+			return http.StatusConflict, err
+
+		default:
+			return http.StatusInternalServerError, err
+		}
+	}
+	go u.roomRedisRepo.PublishMessage(ctx, roomID, "player_unbanned", map[string]string{"user_id": targetID.String(), "by": hostID.String()})
+
+	return fiber.StatusOK, nil
+}
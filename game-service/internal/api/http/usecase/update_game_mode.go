@@ -2,6 +2,9 @@ package httpUsecase
 
 import (
 	"context"
+	"errors"
+	"game-service/domain"
+	"game-service/infra/redis"
 	"net/http"
 	"strconv"
 
@@ -16,20 +19,37 @@ type UpdateRoomGameModeUseCase interface {
 type updateRoomGamemodeUseCase struct {
 	repository    PostgresRepository
 	roomRedisRepo RoomRedisRepository
+	gameHub       GameHub
 }
 
-func NewUpdateRoomGamemodeUseCase(repository PostgresRepository, roomRedisRepo RoomRedisRepository) UpdateRoomGameModeUseCase {
+func NewUpdateRoomGamemodeUseCase(repository PostgresRepository, roomRedisRepo RoomRedisRepository, gameHub GameHub) UpdateRoomGameModeUseCase {
 	return &updateRoomGamemodeUseCase{
 		repository:    repository,
 		roomRedisRepo: roomRedisRepo,
+		gameHub:       gameHub,
 	}
 }
 
 func (u *updateRoomGamemodeUseCase) Execute(ctx context.Context, roomID, userID uuid.UUID, gameModeID int) (int, error) {
+	if !u.gameHub.SupportsGameMode(gameModeID) {
+		return http.StatusBadRequest, unsupportedGameModeError(gameModeID)
+	}
 
-	err := u.repository.UpdateRoomGameMode(ctx, roomID, userID, gameModeID)
+	mode, err := u.repository.UpdateRoomGameMode(ctx, roomID, userID, gameModeID)
 	if err != nil {
-		return http.StatusInternalServerError, err
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return http.StatusBadRequest, err
+
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, err
+
+		default:
+			return http.StatusInternalServerError, err
+		}
 	}
 
 	go u.roomRedisRepo.PublishMessage(ctx, roomID,
@@ -37,7 +57,8 @@ func (u *updateRoomGamemodeUseCase) Execute(ctx context.Context, roomID, userID
 		map[string]string{
 			"user_id":   userID.String(),
 			"mode_id":   strconv.Itoa(gameModeID),
-			"mode_name": "null-1"})
+			"mode_name": mode.Name})
+	go publishLobbyRoom(u.repository, u.roomRedisRepo, roomID, redis.MSG_ROOM_UPDATED)
 
 	return fiber.StatusCreated, nil
 }
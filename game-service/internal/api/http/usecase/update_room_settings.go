@@ -0,0 +1,54 @@
+package httpUsecase
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"game-service/infra/redis"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type UpdateRoomSettingsUseCase interface {
+	Execute(ctx context.Context, roomID, userID uuid.UUID, patch domain.RoomSettingsPatch) (int, domain.RoomSettings, error)
+}
+
+type updateRoomSettingsUseCase struct {
+	repository    PostgresRepository
+	roomRedisRepo RoomRedisRepository
+}
+
+func NewUpdateRoomSettingsUseCase(repository PostgresRepository, roomRedisRepo RoomRedisRepository) UpdateRoomSettingsUseCase {
+	return &updateRoomSettingsUseCase{
+		repository:    repository,
+		roomRedisRepo: roomRedisRepo,
+	}
+}
+
+func (u *updateRoomSettingsUseCase) Execute(ctx context.Context, roomID, userID uuid.UUID, patch domain.RoomSettingsPatch) (int, domain.RoomSettings, error) {
+	settings, err := u.repository.UpdateRoomSettings(ctx, roomID, userID, patch)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return http.StatusBadRequest, domain.RoomSettings{}, err
+
+		case errors.Is(err, domain.ErrForbidden):
+			return http.StatusForbidden, domain.RoomSettings{}, err
+
+		case errors.Is(err, domain.ErrNotFound):
+			return http.StatusNotFound, domain.RoomSettings{}, err
+
+		default:
+			return http.StatusInternalServerError, domain.RoomSettings{}, err
+		}
+	}
+
+	// Hub ayarları veritabanından yeniden okuyup odadakilere yayınlar.
+	go u.roomRedisRepo.PublishMessage(ctx, roomID, "room_settings_changed", map[string]string{"by": userID.String()})
+	// max_players değişmiş olabilir; lobi listesini güncelle
+	go publishLobbyRoom(u.repository, u.roomRedisRepo, roomID, redis.MSG_ROOM_UPDATED)
+
+	return fiber.StatusOK, settings, nil
+}
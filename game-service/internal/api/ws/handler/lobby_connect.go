@@ -0,0 +1,43 @@
+package wsHandler
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	wsUsecase "game-service/internal/api/ws/usecase"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// WebSocketLobbyHandler, oda listesi değişikliklerini (room_created/room_updated/room_deleted) yayınlayan lobi bağlantılarını yönetir.
+type WebSocketLobbyHandler struct {
+	usecase wsUsecase.LobbyFeedUseCase
+}
+type WebSocketLobbyRequest struct {
+}
+
+func NewWebSocketLobbyHandler(usecase wsUsecase.LobbyFeedUseCase) *WebSocketLobbyHandler {
+	return &WebSocketLobbyHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *WebSocketLobbyHandler) HandleWS(c *websocket.Conn, ctx context.Context, req *WebSocketLobbyRequest) {
+	currentUserID, err := uuid.Parse(c.Headers("X-User-Id"))
+	if err != nil {
+		errorMessage := domain.WebSocketErrorMessage{
+			Type:    "error",
+			Message: fmt.Sprintf("Failed to parse user ID: %v", err),
+			Code:    fiber.StatusBadRequest,
+		}
+		if err := c.WriteJSON(errorMessage); err != nil {
+			fmt.Printf("Failed to send error message to client: %v\n", err)
+		}
+		c.Close()
+		return
+	}
+
+	h.usecase.Execute(c, ctx, currentUserID)
+}
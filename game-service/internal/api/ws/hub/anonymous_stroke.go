@@ -0,0 +1,22 @@
+package hub
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// anonymousStrokeData, hamle verisini hub'ın eklediği player_id alanı olmadan JSON'a çevirir.
+// Çizimin sahibinin gizli kalması gereken modlarda (Kırık Telefon, Çizim Yarışması) kullanılır.
+func anonymousStrokeData(moveMap map[string]interface{}) (string, error) {
+	stroke := make(map[string]interface{}, len(moveMap))
+	for key, value := range moveMap {
+		if key != "player_id" {
+			stroke[key] = value
+		}
+	}
+	jsonData, err := json.Marshal(stroke)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal drawing data: %v", err)
+	}
+	return string(jsonData), nil
+}
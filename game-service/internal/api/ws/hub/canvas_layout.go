@@ -0,0 +1,114 @@
+package hub
+
+import (
+	"fmt"
+	"game-service/domain"
+	"math"
+
+	"github.com/google/uuid"
+)
+
+// RegionBounds, karo düzeninde bir oyuncunun çizebileceği alandır. Koordinatlar tuval
+// boyutundan bağımsız olarak 0-1 aralığında normalize edilmiştir.
+type RegionBounds struct {
+	X0 float64 `json:"x0"`
+	Y0 float64 `json:"y0"`
+	X1 float64 `json:"x1"`
+	Y1 float64 `json:"y1"`
+}
+
+func (b RegionBounds) contains(x, y float64) bool {
+	return x >= b.X0 && x <= b.X1 && y >= b.Y0 && y <= b.Y1
+}
+
+// CanvasRegion, Ortak Alan modunda bir oyuncuya ayrılan karo ya da katmandır.
+type CanvasRegion struct {
+	UserID   uuid.UUID     `json:"user_id"`
+	Username string        `json:"username"`
+	Index    int           `json:"index"`            // Karo numarası ya da katman sırası (0'dan başlar)
+	Bounds   *RegionBounds `json:"bounds,omitempty"` // Sadece karo düzeninde
+}
+
+// buildCanvasRegions, turdaki oyunculara karo ya da katman dağıtır. Her turda dağılım bir
+// kaydırılır ki oyuncular tuvalin farklı yerlerine çizsin. Serbest düzende nil döner.
+func buildCanvasRegions(layout string, players []*Player, round int) map[uuid.UUID]*CanvasRegion {
+	count := len(players)
+	if count == 0 || (layout != domain.CanvasLayoutTiles && layout != domain.CanvasLayoutLayers) {
+		return nil
+	}
+
+	// Karolar mümkün olduğunca kareye yakın bir ızgaraya yerleştirilir
+	cols := int(math.Ceil(math.Sqrt(float64(count))))
+	rows := (count + cols - 1) / cols
+
+	regions := make(map[uuid.UUID]*CanvasRegion, count)
+	for i, p := range players {
+		index := (i + round - 1) % count
+		region := &CanvasRegion{UserID: p.UserID, Username: p.Username, Index: index}
+		if layout == domain.CanvasLayoutTiles {
+			col, row := index%cols, index/cols
+			region.Bounds = &RegionBounds{
+				X0: float64(col) / float64(cols),
+				Y0: float64(row) / float64(rows),
+				X1: float64(col+1) / float64(cols),
+				Y1: float64(row+1) / float64(rows),
+			}
+		}
+		regions[p.UserID] = region
+	}
+	return regions
+}
+
+// checkStroke, vuruşun oyuncunun bölgesinde kalıp kalmadığını denetler ve vuruşu bölgesiyle
+// etiketler (istemcinin gönderdiği etiketin üzerine yazılır). Karo düzeninde koordinatsız vuruş
+// kabul edilmez.
+func (region *CanvasRegion) checkStroke(data map[string]interface{}) error {
+	if region.Bounds == nil {
+		data["layer"] = region.Index
+		return nil
+	}
+
+	points, ok := strokePoints(data)
+	if !ok || len(points) == 0 {
+		return fmt.Errorf("stroke must include valid coordinates in tiles layout")
+	}
+	for _, pt := range points {
+		if !region.Bounds.contains(pt[0], pt[1]) {
+			return fmt.Errorf("stroke is outside of your tile")
+		}
+	}
+	data["tile"] = region.Index
+	return nil
+}
+
+// strokePoints, vuruş verisindeki koordinatları toplar: üst seviyedeki x/y ve "points" listesi.
+// Listede koordinatı okunamayan bir nokta varsa vuruş denetlenemeyeceği için false döner.
+func strokePoints(data map[string]interface{}) ([][2]float64, bool) {
+	var points [][2]float64
+	if x, ok := data["x"].(float64); ok {
+		y, ok := data["y"].(float64)
+		if !ok {
+			return nil, false
+		}
+		points = append(points, [2]float64{x, y})
+	}
+	if raw, exists := data["points"]; exists {
+		list, ok := raw.([]interface{})
+		if !ok {
+			return nil, false
+		}
+		for _, item := range list {
+			pt, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			x, okX := pt["x"].(float64)
+			y, okY := pt["y"].(float64)
+			if !okX || !okY {
+				return nil, false
+			}
+			points = append(points, [2]float64{x, y})
+		}
+	}
+	return points, true
+}
@@ -0,0 +1,150 @@
+package hub
+
+import (
+	"context"
+	roomredis "game-service/infra/redis"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	cleanupInterval = time.Minute
+	// Bu süreden uzun süredir bağlı olmayan üyeler odadan çıkarılır.
+	memberInactiveTimeout = 10 * time.Minute
+)
+
+// ResetRuntimeState, servis açılışında veritabanındaki çevrimiçi/oyun durumlarını sıfırlar.
+// Başka instance'lara bağlı üyeler (Redis presence anahtarları) ve onların odaları korunur;
+// sadece hiçbir instance'ta karşılığı olmayan kayıtlar geçersiz sayılır.
+func (h *Hub) ResetRuntimeState(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	live, err := h.liveMembers(ctx)
+	if err != nil {
+		// Hangi üyelerin bağlı olduğu bilinmeden diğer instance'ların kayıtları silinmez
+		log.Printf("Skipping runtime state reset: %v", err)
+		return
+	}
+	if err := h.repo.ResetRuntimeState(ctx, live); err != nil {
+		log.Printf("Failed to reset runtime state: %v", err)
+	}
+}
+
+// StartCleanupJob, üyelik ve oda kayıtlarını düzenli aralıklarla hub durumuyla uzlaştırır.
+func (h *Hub) StartCleanupJob(ctx context.Context) {
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			h.runCleanup(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runCleanup, bir uzlaştırma turu çalıştırır:
+// 1. Uzun süredir bağlı olmayan üyeleri odadan çıkarır,
+// 2. current_players sapmalarını düzeltir,
+// 3. Üyesi kalmayan odaları siler.
+// Değişen odalar lobi akışına yayınlanır.
+func (h *Hub) runCleanup(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	h.refreshSharedPresence(ctx)
+
+	touched := make(map[uuid.UUID]bool)
+	h.removeInactiveMembers(ctx, touched)
+
+	reconciled, err := h.repo.ReconcileRoomPlayerCounts(ctx)
+	if err != nil {
+		log.Printf("CLEANUP: %v", err)
+	}
+	for _, roomID := range reconciled {
+		touched[roomID] = true
+	}
+
+	deleted, err := h.repo.DeleteEmptyRooms(ctx)
+	if err != nil {
+		log.Printf("CLEANUP: %v", err)
+	}
+	for _, roomID := range deleted {
+		delete(touched, roomID)
+		h.gameHub.forgetRoom(roomID)
+		h.publishLobbyEvent(ctx, roomredis.MSG_ROOM_DELETED, roomID, map[string]string{"room_id": roomID.String()})
+	}
+
+	for roomID := range touched {
+		h.publishLobbyRoom(ctx, roomID)
+	}
+}
+
+// removeInactiveMembers, memberInactiveTimeout süresinden uzun süredir görünmeyen üyeleri odadan çıkarır.
+// Hâlâ bağlı olanların son görülme zamanı tazelenir; aktif oyundakiler grace period'a bırakılır.
+// Başka bir instance'a bağlı üyelere dokunulmaz; onları kendi instance'ının temizlik işi tazeler.
+func (h *Hub) removeInactiveMembers(ctx context.Context, touched map[uuid.UUID]bool) {
+	members, err := h.repo.GetStaleMembers(ctx, memberInactiveTimeout)
+	if err != nil {
+		log.Printf("CLEANUP: %v", err)
+		return
+	}
+
+	for _, member := range members {
+		if h.isClientConnected(member.RoomID, member.UserID) {
+			h.markPresence(member.RoomID, member.UserID, true)
+			continue
+		}
+		if h.isConnectedElsewhere(ctx, member.RoomID, member.UserID) {
+			continue
+		}
+		if h.IsPlayerInActiveGame(member.RoomID, member.UserID) {
+			continue
+		}
+
+		newHostID, err := h.repo.LeaveRoom(ctx, member.RoomID, member.UserID)
+		if err != nil {
+			log.Printf("CLEANUP: failed to remove inactive member %s from room %s: %v", member.UserID, member.RoomID, err)
+			continue
+		}
+		log.Printf("CLEANUP: inactive member %s removed from room %s", member.UserID, member.RoomID)
+		touched[member.RoomID] = true
+
+		if h.GetRoomClientCount(member.RoomID) == 0 {
+			continue
+		}
+		h.BroadcastMessage(member.RoomID, &Message{
+			Type: "player_left",
+			Content: map[string]interface{}{
+				"room_id": member.RoomID,
+				"user_id": member.UserID,
+				"reason":  "inactive",
+			},
+		})
+		if newHostID != uuid.Nil {
+			h.ApplyHostChange(member.RoomID, member.UserID, newHostID, "host_inactive")
+		}
+	}
+}
+
+// forgetRoom, silinen odanın bellekteki ayarlarını ve oturum sıralamasını temizler.
+func (g *GameHub) forgetRoom(roomID uuid.UUID) {
+	g.mutex.Lock()
+	if _, active := g.activeGames[roomID]; !active {
+		delete(g.roomSettings, roomID)
+	}
+	g.mutex.Unlock()
+
+	g.hub.resetLobby(roomID)
+	g.hub.clearLobbyTeams(roomID)
+	g.hub.voteMutex.Lock()
+	delete(g.hub.voteKickCooldowns, roomID)
+	g.hub.voteMutex.Unlock()
+
+	g.forgetStandings(roomID)
+	g.forgetWordSubmission(roomID)
+}
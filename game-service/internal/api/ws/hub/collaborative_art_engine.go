@@ -3,8 +3,10 @@ package hub
 import (
 	"encoding/json"
 	"fmt"
+	"game-service/domain"
 	"log"
 	"math/rand"
+	"sort"
 
 	// "sync" // Mutex'i Game struct'ı üzerinden kullanacağız
 
@@ -18,6 +20,10 @@ type CollaborativeArtData struct {
 	RoundHistory   map[int]RoundRecord // Tur Numarası -> O turdaki TÜM vuruşlar
 	CurrentStrokes []DrawingStroke     // Mevcut turda yapılan vuruşlar
 
+	// Tuval düzeni: serbest, karolar ya da katmanlar
+	Layout       string
+	Regions      map[uuid.UUID]*CanvasRegion // Bu turda oyunculara ayrılan karo/katmanlar (serbest düzende nil)
+	RoundRegions map[int][]*CanvasRegion     `json:"-"` // Tur Numarası -> O turun dağılımı (final rapor için)
 }
 
 // CollaborativeArtEngine, "Ortak Sanat Projesi" oyununun mantığını uygular.
@@ -46,14 +52,20 @@ func (cae *CollaborativeArtEngine) InitGame(game *Game, players []*Player) error
 	}
 
 	// Özel verileri oluştur
+	layout := game.CanvasLayout
+	if layout == "" {
+		layout = domain.CanvasLayoutFree
+	}
 	artData := &CollaborativeArtData{
 		CurrentWord:    "",
 		RoundHistory:   make(map[int]RoundRecord),
 		CurrentStrokes: []DrawingStroke{},
+		Layout:         layout,
+		RoundRegions:   make(map[int][]*CanvasRegion),
 	}
 	game.ModeData = artData
 
-	log.Printf("Initialized Collaborative Art game for room %s.", game.RoomID)
+	log.Printf("Initialized Collaborative Art game for room %s (layout: %s).", game.RoomID, layout)
 	return nil
 }
 
@@ -79,16 +91,23 @@ func (cae *CollaborativeArtEngine) ProcessMove(game *Game, playerID uuid.UUID, m
 	// 🔑 ANA DEĞİŞİKLİK: Çizim vuruşunu oyuncu ID'si ile birlikte sakla
 	if actionType == "canvas_action" || actionType == "draw" {
 
-		jsonData, err := json.Marshal(data)
-		if err != nil {
-			return fmt.Errorf("failed to marshal drawing data: %v", err)
-		}
-
 		artData, _ := game.ModeData.(*CollaborativeArtData)
 		if artData == nil {
 			// Loglama eklemek isteyebilirsiniz: log.Printf("HATA: ModeData DrawArtData değil veya nil.")
 			return fmt.Errorf("oyun modu verisi eksik veya yanlış tipte")
 		}
+
+		// Karo/katman düzeninde vuruş oyuncunun bölgesinde kalmalı
+		if artData.Regions != nil {
+			if err := cae.checkRegion(game, artData, playerID, data); err != nil {
+				return err
+			}
+		}
+
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("failed to marshal drawing data: %v", err)
+		}
 		newStroke := DrawingStroke{
 			PlayerID: playerID,
 			Data:     string(jsonData),
@@ -108,6 +127,25 @@ func (cae *CollaborativeArtEngine) ProcessMove(game *Game, playerID uuid.UUID, m
 	return nil
 }
 
+// checkRegion, vuruşu oyuncunun karo/katmanına göre denetler ve etiketler. Reddedilen vuruş
+// oyuncuya bildirilir ki istemci kendi tuvalinden geri alabilsin.
+func (cae *CollaborativeArtEngine) checkRegion(game *Game, artData *CollaborativeArtData, playerID uuid.UUID, data map[string]interface{}) error {
+	err := fmt.Errorf("you have no canvas region this round")
+	if region, ok := artData.Regions[playerID]; ok {
+		err = region.checkStroke(data)
+	}
+	if err != nil {
+		cae.gameHub.hub.SendMessageToUser(game.RoomID, playerID, &Message{
+			Type: "stroke_rejected",
+			Content: map[string]interface{}{
+				"reason": err.Error(),
+				"layout": artData.Layout,
+			},
+		})
+	}
+	return err
+}
+
 // EndRound, turu sonlandırır, veriyi kaydeder ve bir sonraki tur için hazırlar.
 func (cae *CollaborativeArtEngine) EndRound(game *Game, reason string) bool {
 	// game.Mutex.Lock()
@@ -119,7 +157,7 @@ func (cae *CollaborativeArtEngine) EndRound(game *Game, reason string) bool {
 		// Hata yönetimi burada olmalı
 		return false
 	}
-	fmt.Printf("Ending collaborative art round %d  asrdata", endedRoundNum, artData.RoundHistory)
+	fmt.Printf("Ending collaborative art round %d  asrdata %v\n", endedRoundNum, artData.RoundHistory)
 	record, exists := artData.RoundHistory[endedRoundNum]
 	if !exists {
 		// Eğer StartRound doğru çalışmadıysa (hiç olmamalı)
@@ -187,9 +225,19 @@ func (cae *CollaborativeArtEngine) SendFinalArtReport(game *Game) {
 		// }
 
 		// Rapor objesini hazırla
+		// Kimin ne kadar çizdiği, düzenli modlarda da hangi karo/katmana çizdiği gösterilir
+		contributions := make(map[uuid.UUID]int)
+		for _, stroke := range record.AllStrokes {
+			contributions[stroke.PlayerID]++
+		}
 		roundReport := map[string]interface{}{
-			"word":    record.Word,
-			"actions": record.AllStrokes,
+			"word":          record.Word,
+			"actions":       record.AllStrokes,
+			"layout":        artData.Layout,
+			"contributions": contributions,
+		}
+		if regions, ok := artData.RoundRegions[roundNum]; ok {
+			roundReport["regions"] = regions
 		}
 
 		finalReport[fmt.Sprintf("round_%d", roundNum)] = roundReport
@@ -228,7 +276,7 @@ func (dge *CollaborativeArtEngine) SendPreparationNotifications(game *Game) {
 		},
 	})
 
-	log.Printf("Preparation notifications sent for room %s. Next drawer: %s, Duration: %ds",
+	log.Printf("Preparation notifications sent for room %s. Duration: %ds",
 		game.RoomID, game.PreparationDuration)
 }
 
@@ -253,6 +301,15 @@ func (cae *CollaborativeArtEngine) StartRound(game *Game) error {
 	artData.CurrentWord = selectedWord
 	artData.CurrentStrokes = []DrawingStroke{}
 	currentRoundNum := game.TurnCount
+	artData.Regions = buildCanvasRegions(artData.Layout, game.Players, currentRoundNum)
+	if artData.Regions != nil {
+		ordered := make([]*CanvasRegion, 0, len(artData.Regions))
+		for _, region := range artData.Regions {
+			ordered = append(ordered, region)
+		}
+		sort.Slice(ordered, func(i, j int) bool { return ordered[i].Index < ordered[j].Index })
+		artData.RoundRegions[currentRoundNum] = ordered
+	}
 	artData.RoundHistory[currentRoundNum] = RoundRecord{
 		Word: selectedWord,
 		// ActivePlayer'ın doğru ayarlandığından emin olun!
@@ -265,16 +322,41 @@ func (cae *CollaborativeArtEngine) StartRound(game *Game) error {
 
 	// 3. Tüm oyunculara tur başlangıcını (gizli kelime ile) bildir
 	for _, p := range game.Players {
+		content := map[string]interface{}{
+			"drawer_id": p.UserID,
+			"word":      selectedWord,
+			"duration":  game.RoundDuration,
+			"layout":    artData.Layout,
+		}
+		if region, ok := artData.Regions[p.UserID]; ok {
+			content["region"] = region
+		}
 		cae.gameHub.hub.SendMessageToUser(game.RoomID, p.UserID, &Message{
-			Type: "round_start_drawer",
+			Type:    "round_start_drawer",
+			Content: content,
+		})
+	}
+	if artData.Regions != nil {
+		cae.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+			Type: "canvas_layout",
 			Content: map[string]interface{}{
-				"drawer_id": p.UserID,
-				"word":      selectedWord,
-				"duration":  game.RoundDuration,
+				"layout":       artData.Layout,
+				"round_number": currentRoundNum,
+				"regions":      artData.RoundRegions[currentRoundNum],
 			},
 		})
 	}
 
+	cae.gameHub.hub.BroadcastToSpectators(game.RoomID, &Message{
+		Type: "round_start_spectator",
+		Content: map[string]interface{}{
+			"word":         selectedWord,
+			"round_number": game.TurnCount,
+			"total_rounds": game.TotalRounds,
+			"duration":     game.RoundDuration,
+		},
+	})
+
 	// 4. Genel oyun durumu yayınını yap (tur bilgisinin güncellenmesi için)
 	// cae.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
 	// 	Type:    "game_state_update",
@@ -0,0 +1,516 @@
+// hub/contest_game_engine.go
+package hub
+
+import (
+	"fmt"
+	"game-service/domain"
+	"log"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Çizim Yarışması aşamaları
+const (
+	ContestPhaseDrawing = "drawing"
+	ContestPhaseVoting  = "voting"
+)
+
+// Çizim Yarışması puanları
+const (
+	contestVoteScore   = 10 // Alınan her oy için
+	contestWinnerBonus = 5  // Turda en çok oyu alan çizim(ler) için
+)
+
+// Tur bitiş sebepleri (yarışma modu)
+const (
+	RoundEndAllVoted  = "all_voted"
+	RoundEndNoEntries = "no_entries"
+)
+
+// ContestGameEngine, "Çizim Yarışması" modunun mantığını uygular. Herkes aynı kelimeyi kendi gizli
+// tuvalinde çizer; çizim süresi bitince çizimler isimsiz olarak galeride gösterilir ve oylanır.
+type ContestGameEngine struct {
+	gameHub *GameHub
+}
+
+// ContestEntry, galeride oylanan bir çizimdir. Çizenin kimliği sonuçlara kadar gizlidir.
+type ContestEntry struct {
+	EntryID  int             `json:"entry_id"`
+	PlayerID uuid.UUID       `json:"-"`
+	Strokes  []DrawingStroke `json:"strokes"`
+}
+
+// ContestResult, bir çizimin tur sonucudur.
+type ContestResult struct {
+	EntryID  int       `json:"entry_id"`
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+	Votes    int       `json:"votes"`
+	Points   int       `json:"points"`
+	Winner   bool      `json:"winner"`
+}
+
+type ContestGameData struct {
+	Prompt string `json:"prompt"`
+	Phase  string `json:"phase"`
+
+	// Tuvaller oylamaya kadar, oylar sonuçlara kadar gizlidir
+	Canvases       map[uuid.UUID][]DrawingStroke `json:"-"` // oyuncu -> gizli tuval
+	Finished       map[uuid.UUID]bool            `json:"-"` // Çizimini erken teslim edenler
+	Entries        []*ContestEntry               `json:"-"`
+	Votes          map[uuid.UUID]int             `json:"-"` // oy veren -> entry_id
+	RoundHistory   map[int]RoundRecord           `json:"-"`
+	Results        map[int][]ContestResult       `json:"-"`
+	UsedPrompts    map[string]bool               `json:"-"`
+	DrawDuration   int                           `json:"-"`
+	VotingDuration int                           `json:"-"`
+
+	drawTimer   *time.Timer // Çizim aşamasını bitirip oylamayı açar
+	roundEnding bool        // Tur bitirilmek üzere; tekrar bitirilmesini engeller
+}
+
+func NewContestGameEngine(gameHub *GameHub) *ContestGameEngine {
+	return &ContestGameEngine{gameHub: gameHub}
+}
+
+// InitGame, yarışmanın ilk ayarlarını yapar. Bu modda çizer yoktur; herkes aynı anda çizer.
+func (cge *ContestGameEngine) InitGame(game *Game, players []*Player) error {
+	if len(players) < 3 {
+		return fmt.Errorf("contest mode needs at least 3 players")
+	}
+	for _, p := range players {
+		p.Score = 0
+	}
+
+	game.Players = players
+	game.State = GameStateInProgress
+	game.TurnCount = 1
+	game.ActivePlayer = uuid.Nil
+	game.ModeData = &ContestGameData{
+		Canvases:       make(map[uuid.UUID][]DrawingStroke),
+		Finished:       make(map[uuid.UUID]bool),
+		Votes:          make(map[uuid.UUID]int),
+		RoundHistory:   make(map[int]RoundRecord),
+		Results:        make(map[int][]ContestResult),
+		UsedPrompts:    make(map[string]bool),
+		DrawDuration:   game.RoundDuration,
+		VotingDuration: game.VotingDuration,
+	}
+
+	log.Printf("Initialized drawing contest for room %s with %d players", game.RoomID, len(players))
+	return nil
+}
+
+// StartRound, turun kelimesini herkese duyurur ve çizim aşamasını başlatır. Tur zamanlayıcısı
+// çizim ve oylama sürelerinin toplamına kurulur; oylama açılınca oylama süresine göre yeniden kurulur.
+func (cge *ContestGameEngine) StartRound(game *Game) error {
+	data, ok := game.ModeData.(*ContestGameData)
+	if !ok {
+		return fmt.Errorf("mode data is not of expected type ContestGameData")
+	}
+
+	prompt := cge.selectPrompt(data)
+	data.Prompt = prompt
+	data.Phase = ContestPhaseDrawing
+	data.Canvases = make(map[uuid.UUID][]DrawingStroke)
+	data.Finished = make(map[uuid.UUID]bool)
+	data.Entries = nil
+	data.Votes = make(map[uuid.UUID]int)
+	data.roundEnding = false
+	data.RoundHistory[game.TurnCount] = RoundRecord{
+		Word:       prompt,
+		AllStrokes: []DrawingStroke{},
+		StartedAt:  time.Now(),
+		Guesses:    []domain.GuessRecord{},
+		Points:     make(map[uuid.UUID]int),
+	}
+	game.RoundDuration = data.DrawDuration + data.VotingDuration
+
+	cge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type: "contest_round_start",
+		Content: map[string]interface{}{
+			"prompt":          prompt,
+			"round_number":    game.TurnCount,
+			"total_rounds":    game.TotalRounds,
+			"duration":        data.DrawDuration,
+			"voting_duration": data.VotingDuration,
+		},
+	})
+
+	round := game.TurnCount
+	data.drawTimer = time.AfterFunc(time.Duration(data.DrawDuration)*time.Second, func() {
+		cge.onDrawingTimeUp(game, round)
+	})
+	return nil
+}
+
+// selectPrompt, oyunda daha önce çizilmemiş bir kelime seçer; liste biterse tekrar serbesttir.
+func (cge *ContestGameEngine) selectPrompt(data *ContestGameData) string {
+	candidates := make([]string, 0, len(defaultWordList))
+	for _, word := range defaultWordList {
+		if !data.UsedPrompts[word] {
+			candidates = append(candidates, word)
+		}
+	}
+	if len(candidates) == 0 {
+		data.UsedPrompts = make(map[string]bool)
+		candidates = defaultWordList
+	}
+	prompt := candidates[rand.Intn(len(candidates))]
+	data.UsedPrompts[prompt] = true
+	return prompt
+}
+
+// onDrawingTimeUp, çizim süresi dolunca oylamayı açar.
+func (cge *ContestGameEngine) onDrawingTimeUp(game *Game, round int) {
+	game.Mutex.Lock()
+	defer game.Mutex.Unlock()
+
+	data, ok := game.ModeData.(*ContestGameData)
+	if !ok || game.State != GameStateInProgress || game.TurnCount != round ||
+		data.Phase != ContestPhaseDrawing || data.roundEnding {
+		return
+	}
+	if game.IsPaused {
+		// Duraklatma süresi çizim süresinden sayılmaz; devam edince oylama açılır
+		data.drawTimer = time.AfterFunc(time.Second, func() {
+			cge.onDrawingTimeUp(game, round)
+		})
+		return
+	}
+	data.drawTimer = nil
+	cge.openVoting(game, data)
+}
+
+// stopDrawTimer, varsa çizim aşaması zamanlayıcısını durdurur.
+func (data *ContestGameData) stopDrawTimer() {
+	if data.drawTimer != nil {
+		data.drawTimer.Stop()
+		data.drawTimer = nil
+	}
+}
+
+// openVoting, tuvalleri isimsiz galeri girdilerine çevirir ve oylamayı başlatır.
+// Hiç çizim yoksa tur oylamasız biter. game.Mutex tutulurken çağrılır.
+func (cge *ContestGameEngine) openVoting(game *Game, data *ContestGameData) {
+	data.stopDrawTimer()
+	data.Phase = ContestPhaseVoting
+
+	artists := make([]uuid.UUID, 0, len(data.Canvases))
+	for playerID, strokes := range data.Canvases {
+		if len(strokes) > 0 {
+			artists = append(artists, playerID)
+		}
+	}
+	// Galeri sırası çizeni ele vermesin diye karıştırılır
+	rand.Shuffle(len(artists), func(i, j int) { artists[i], artists[j] = artists[j], artists[i] })
+	data.Entries = make([]*ContestEntry, 0, len(artists))
+	for i, playerID := range artists {
+		data.Entries = append(data.Entries, &ContestEntry{
+			EntryID:  i + 1,
+			PlayerID: playerID,
+			Strokes:  data.Canvases[playerID],
+		})
+	}
+
+	roomID := game.RoomID
+	if len(data.Entries) == 0 {
+		data.roundEnding = true
+		go cge.gameHub.handleRoundEnd(roomID, RoundEndNoEntries)
+		return
+	}
+
+	ownEntry := make(map[uuid.UUID]int, len(data.Entries))
+	for _, entry := range data.Entries {
+		ownEntry[entry.PlayerID] = entry.EntryID
+	}
+	for _, p := range game.Players {
+		content := map[string]interface{}{
+			"round_number": game.TurnCount,
+			"prompt":       data.Prompt,
+			"entries":      data.Entries,
+			"duration":     data.VotingDuration,
+		}
+		if entryID, ok := ownEntry[p.UserID]; ok {
+			content["own_entry_id"] = entryID
+		}
+		cge.gameHub.hub.SendMessageToUser(roomID, p.UserID, &Message{
+			Type:    "contest_gallery",
+			Content: content,
+		})
+	}
+	cge.gameHub.hub.BroadcastToSpectators(roomID, &Message{
+		Type: "contest_gallery",
+		Content: map[string]interface{}{
+			"round_number": game.TurnCount,
+			"prompt":       data.Prompt,
+			"entries":      data.Entries,
+			"duration":     data.VotingDuration,
+		},
+	})
+
+	// Tur zamanlayıcısı artık oylama süresini sayar
+	go cge.restartRoundTimer(game, game.TurnCount, time.Duration(data.VotingDuration)*time.Second)
+	log.Printf("Contest voting opened for room %s with %d entries", roomID, len(data.Entries))
+}
+
+// restartRoundTimer, oylama açıldığında tur zamanlayıcısını oylama süresine göre yeniden kurar.
+// game.Mutex tutulurken GameHub kilidi alınamayacağı için ayrı goroutine'de çalışır; bu arada
+// tur bittiyse zamanlayıcı kurulmaz.
+func (cge *ContestGameEngine) restartRoundTimer(game *Game, round int, duration time.Duration) {
+	game.Mutex.RLock()
+	data, ok := game.ModeData.(*ContestGameData)
+	stillVoting := ok && game.TurnCount == round && data.Phase == ContestPhaseVoting && !data.roundEnding
+	game.Mutex.RUnlock()
+	if !stillVoting {
+		return
+	}
+	cge.gameHub.startRoundTimer(game.RoomID, duration)
+}
+
+// ProcessMove, gizli tuvale çizimi, erken teslimi ve oyları işler.
+func (cge *ContestGameEngine) ProcessMove(game *Game, playerID uuid.UUID, moveData interface{}) error {
+	game.Mutex.Lock()
+	defer game.Mutex.Unlock()
+
+	if game.State != GameStateInProgress {
+		return fmt.Errorf("game is not in progress")
+	}
+	moveMap, ok := moveData.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("invalid move data format")
+	}
+	actionType, ok := moveMap["type"].(string)
+	if !ok {
+		return fmt.Errorf("move data missing 'type' field")
+	}
+	data, _ := game.ModeData.(*ContestGameData)
+	if data == nil {
+		return fmt.Errorf("oyun modu verisi eksik veya yanlış tipte")
+	}
+	if data.roundEnding {
+		return fmt.Errorf("round is already ending")
+	}
+
+	switch actionType {
+	case "draw", "canvas_action":
+		if data.Phase != ContestPhaseDrawing {
+			return fmt.Errorf("drawing phase is over")
+		}
+		if data.Finished[playerID] {
+			return fmt.Errorf("you already submitted your drawing")
+		}
+		// Vuruş kimseye yayınlanmaz; tuval oylamaya kadar gizlidir. Galeride çizen belli
+		// olmasın diye vuruşa oyuncu kimliği yazılmaz (tuvalin sahibi map anahtarıdır).
+		strokeData, err := anonymousStrokeData(moveMap)
+		if err != nil {
+			return err
+		}
+		data.Canvases[playerID] = append(data.Canvases[playerID], DrawingStroke{Data: strokeData})
+	case "submit_drawing":
+		if data.Phase != ContestPhaseDrawing {
+			return fmt.Errorf("drawing phase is over")
+		}
+		data.Finished[playerID] = true
+		cge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+			Type: "contest_drawing_progress",
+			Content: map[string]interface{}{
+				"finished": len(data.Finished),
+				"total":    len(game.Players),
+			},
+		})
+		// Herkes teslim ettiyse oylama beklemeden açılır
+		if len(data.Finished) >= len(game.Players) {
+			cge.openVoting(game, data)
+		}
+	case "vote":
+		return cge.castVote(game, data, playerID, moveMap)
+	}
+	return nil
+}
+
+// castVote, oyuncunun oyunu kaydeder. Oyuncu kendi çizimine oy veremez; oylama bitene kadar
+// oyunu değiştirebilir. game.Mutex tutulurken çağrılır.
+func (cge *ContestGameEngine) castVote(game *Game, data *ContestGameData, playerID uuid.UUID, moveMap map[string]interface{}) error {
+	if data.Phase != ContestPhaseVoting {
+		return fmt.Errorf("voting is not open")
+	}
+	entryValue, ok := moveMap["entry_id"].(float64)
+	if !ok {
+		return fmt.Errorf("vote data missing 'entry_id' field")
+	}
+	entryID := int(entryValue)
+	var entry *ContestEntry
+	for _, e := range data.Entries {
+		if e.EntryID == entryID {
+			entry = e
+			break
+		}
+	}
+	if entry == nil {
+		return fmt.Errorf("drawing not found")
+	}
+	if entry.PlayerID == playerID {
+		return fmt.Errorf("you cannot vote for your own drawing")
+	}
+	data.Votes[playerID] = entryID
+
+	voted, eligible := cge.voteProgress(game, data)
+	cge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type: "contest_vote_progress",
+		Content: map[string]interface{}{
+			"voted": voted,
+			"total": eligible,
+		},
+	})
+	if voted >= eligible {
+		data.roundEnding = true
+		go cge.gameHub.handleRoundEnd(game.RoomID, RoundEndAllVoted)
+	}
+	return nil
+}
+
+// voteProgress, oy veren ve oy verebilecek oyuncu sayısını döner. Galeride sadece kendi çizimi
+// olan oyuncu oy veremeyeceği için sayılmaz.
+func (cge *ContestGameEngine) voteProgress(game *Game, data *ContestGameData) (int, int) {
+	voted, eligible := 0, 0
+	for _, p := range game.Players {
+		if len(data.Entries) == 1 && data.Entries[0].PlayerID == p.UserID {
+			continue
+		}
+		eligible++
+		if _, ok := data.Votes[p.UserID]; ok {
+			voted++
+		}
+	}
+	return voted, eligible
+}
+
+// EndRound, oyları sayar, puanları dağıtır ve sonuçları yayınlar.
+func (cge *ContestGameEngine) EndRound(game *Game, reason string) bool {
+	data, ok := game.ModeData.(*ContestGameData)
+	if !ok {
+		return false
+	}
+	data.stopDrawTimer()
+	data.roundEnding = true
+
+	results := cge.tallyVotes(game, data)
+	data.Results[game.TurnCount] = results
+	cge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type: "contest_results",
+		Content: map[string]interface{}{
+			"round_number": game.TurnCount,
+			"prompt":       data.Prompt,
+			"reason":       reason,
+			"results":      results,
+			"scores":       game.Players,
+		},
+	})
+	log.Printf("Contest round %d ended in room %s (%s)", game.TurnCount, game.RoomID, reason)
+
+	game.TurnCount++
+	if game.TurnCount > game.TotalRounds {
+		game.State = GameStateOver
+		return false
+	}
+	return true
+}
+
+// tallyVotes, oyları çizimlere göre sayar ve puanları oyunculara ve tur kaydına işler.
+// game.Mutex tutulurken çağrılır.
+func (cge *ContestGameEngine) tallyVotes(game *Game, data *ContestGameData) []ContestResult {
+	votes := make(map[int]int, len(data.Entries))
+	for _, entryID := range data.Votes {
+		votes[entryID]++
+	}
+	topVotes := 0
+	for _, count := range votes {
+		topVotes = max(topVotes, count)
+	}
+
+	usernames := make(map[uuid.UUID]string, len(game.Players))
+	for _, p := range game.Players {
+		usernames[p.UserID] = p.Username
+	}
+	record := data.RoundHistory[game.TurnCount]
+	if record.Points == nil {
+		record.Points = make(map[uuid.UUID]int)
+	}
+
+	results := make([]ContestResult, 0, len(data.Entries))
+	points := make(map[uuid.UUID]int, len(data.Entries))
+	for _, entry := range data.Entries {
+		result := ContestResult{
+			EntryID:  entry.EntryID,
+			UserID:   entry.PlayerID,
+			Username: usernames[entry.PlayerID],
+			Votes:    votes[entry.EntryID],
+		}
+		result.Points = result.Votes * contestVoteScore
+		if topVotes > 0 && result.Votes == topVotes {
+			result.Winner = true
+			result.Points += contestWinnerBonus
+		}
+		points[entry.PlayerID] = result.Points
+		record.Points[entry.PlayerID] += result.Points
+		record.AllStrokes = append(record.AllStrokes, entry.Strokes...)
+		results = append(results, result)
+	}
+	for _, p := range game.Players {
+		p.Score += points[p.UserID]
+	}
+	data.RoundHistory[game.TurnCount] = record
+
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Votes > results[j].Votes })
+	return results
+}
+
+// SendPreparationNotifications, sıradaki tur için herkese hazırlık bildirimi gönderir.
+func (cge *ContestGameEngine) SendPreparationNotifications(game *Game) {
+	cge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type: "round_preparation",
+		Content: map[string]interface{}{
+			"role":                 "artist",
+			"preparation_duration": game.PreparationDuration,
+			"round_number":         game.TurnCount,
+			"total_rounds":         game.TotalRounds,
+			"message":              fmt.Sprintf("%d saniye içinde yarışma turu başlayacak. Fırçanı hazırla!", game.PreparationDuration),
+		},
+	})
+}
+
+// RoundSummaries, turların kelimelerini ve oylardan gelen puanları özet için döner.
+func (cge *ContestGameEngine) RoundSummaries(game *Game) []domain.RoundSummary {
+	data, ok := game.ModeData.(*ContestGameData)
+	if !ok || data == nil {
+		return nil
+	}
+	return roundSummaries(data.RoundHistory, game.Players)
+}
+
+// SendGameOver, tur sonuçlarını ve oyun sonu özetini yayınlar.
+func (cge *ContestGameEngine) SendGameOver(game *Game, summary *domain.GameSummary) {
+	content := map[string]interface{}{}
+	if data, ok := game.ModeData.(*ContestGameData); ok {
+		rounds := make(map[string]interface{}, len(data.Results))
+		for roundNum, results := range data.Results {
+			rounds[fmt.Sprintf("round_%d", roundNum)] = map[string]interface{}{
+				"prompt":  data.RoundHistory[roundNum].Word,
+				"results": results,
+			}
+		}
+		content["rounds"] = rounds
+	}
+	if summary != nil {
+		content["summary"] = summary
+	}
+	cge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type:    "game_over",
+		Content: content,
+	})
+	log.Printf("Contest results published for room %s.", game.RoomID)
+}
@@ -0,0 +1,152 @@
+package hub
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tur bitiş sebepleri (çizer kaynaklı)
+const (
+	RoundEndDrawerSkipped = "drawer_skipped"
+	RoundEndDrawerAFK     = "drawer_afk"
+)
+
+// armIdleTimer, çizerin hareketsizlik zamanlayıcısını (yeniden) kurar. Her vuruşta süre baştan başlar.
+// game.Mutex tutulurken çağrılmalıdır.
+func (dge *DrawingGameEngine) armIdleTimer(game *Game, artData *DrawArtData) {
+	artData.stopIdleTimer()
+	if game.DrawerIdleTimeout <= 0 || artData.roundEnding {
+		return
+	}
+
+	round := game.TurnCount
+	drawerID := game.ActivePlayer
+	artData.idleTimer = time.AfterFunc(time.Duration(game.DrawerIdleTimeout)*time.Second, func() {
+		dge.onDrawerIdle(game, round, drawerID)
+	})
+}
+
+// stopIdleTimer, varsa hareketsizlik zamanlayıcısını durdurur.
+func (artData *DrawArtData) stopIdleTimer() {
+	if artData.idleTimer != nil {
+		artData.idleTimer.Stop()
+		artData.idleTimer = nil
+	}
+}
+
+// onDrawerIdle, çizer süre boyunca hiç çizmediyse turu "drawer_afk" sebebiyle bitirir.
+func (dge *DrawingGameEngine) onDrawerIdle(game *Game, round int, drawerID uuid.UUID) {
+	game.Mutex.Lock()
+	artData, ok := game.ModeData.(*DrawArtData)
+	if !ok || game.State != GameStateInProgress || game.TurnCount != round ||
+		game.ActivePlayer != drawerID || artData.roundEnding {
+		game.Mutex.Unlock()
+		return
+	}
+	if game.IsPaused {
+		// Duraklatma süresi hareketsizlikten sayılmaz
+		dge.armIdleTimer(game, artData)
+		game.Mutex.Unlock()
+		return
+	}
+	artData.roundEnding = true
+	artData.idleTimer = nil
+	game.Mutex.Unlock()
+
+	log.Printf("Drawer %s is AFK in room %s (round %d)", drawerID, game.RoomID, round)
+	dge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type: "drawer_afk",
+		Content: map[string]interface{}{
+			"drawer_id":    drawerID,
+			"round_number": round,
+			"idle_timeout": game.DrawerIdleTimeout,
+		},
+	})
+	go dge.gameHub.handleRoundEnd(game.RoomID, RoundEndDrawerAFK)
+}
+
+// skipTurn, çizerin sırasını pas geçmesini işler; ayarlıysa puan cezası uygulanır.
+// game.Mutex tutulurken çağrılır.
+func (dge *DrawingGameEngine) skipTurn(game *Game, artData *DrawArtData, playerID uuid.UUID) error {
+	if game.ActivePlayer != playerID {
+		return fmt.Errorf("only the drawer can skip the turn")
+	}
+	if artData.roundEnding {
+		return fmt.Errorf("round is already ending")
+	}
+	artData.roundEnding = true
+	artData.stopIdleTimer()
+	// Pas geçmek bilinçli bir karardır; AFK sayılmaz
+	delete(artData.AfkStrikes, playerID)
+
+	// Puan sıfırın altına düşmez
+	penalty := 0
+	for _, p := range game.Players {
+		if p.UserID == playerID {
+			penalty = min(game.SkipPenalty, p.Score)
+			p.Score -= penalty
+			break
+		}
+	}
+	if record, exists := artData.RoundHistory[game.TurnCount]; exists && penalty > 0 {
+		if record.Points == nil {
+			record.Points = make(map[uuid.UUID]int)
+		}
+		record.Points[playerID] -= penalty
+		artData.RoundHistory[game.TurnCount] = record
+	}
+
+	log.Printf("Drawer %s skipped round %d in room %s (penalty %d)", playerID, game.TurnCount, game.RoomID, penalty)
+	dge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type: "drawer_skipped",
+		Content: map[string]interface{}{
+			"drawer_id":    playerID,
+			"round_number": game.TurnCount,
+			"penalty":      penalty,
+		},
+	})
+	go dge.gameHub.handleRoundEnd(game.RoomID, RoundEndDrawerSkipped)
+	return nil
+}
+
+// recordAfkRound, AFK biten turun çizerine bir uyarı sayar. Sınır aşılırsa oyuncu çizer
+// sırasından çıkarılır (tahmin etmeye devam edebilir). game.Mutex tutulurken çağrılır.
+func (dge *DrawingGameEngine) recordAfkRound(game *Game, artData *DrawArtData, drawerID uuid.UUID) {
+	artData.AfkStrikes[drawerID]++
+	strikes := artData.AfkStrikes[drawerID]
+	if game.AfkRoundsLimit <= 0 || strikes < game.AfkRoundsLimit || artData.Benched[drawerID] {
+		return
+	}
+
+	artData.Benched[drawerID] = true
+	log.Printf("Player %s removed from drawing rotation in room %s after %d AFK rounds", drawerID, game.RoomID, strikes)
+	dge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type: "player_removed_from_rotation",
+		Content: map[string]interface{}{
+			"user_id":    drawerID,
+			"afk_rounds": strikes,
+			"message":    "Üst üste çizim yapmadığı için çizer sırasından çıkarıldı.",
+		},
+	})
+}
+
+// rejoinRotation, sıradan çıkarılan oyuncunun tekrar çizer olmak istediğini işler.
+// game.Mutex tutulurken çağrılır.
+func (dge *DrawingGameEngine) rejoinRotation(game *Game, artData *DrawArtData, playerID uuid.UUID) error {
+	if !artData.Benched[playerID] {
+		return fmt.Errorf("player is already in the drawing rotation")
+	}
+	delete(artData.Benched, playerID)
+	delete(artData.AfkStrikes, playerID)
+
+	dge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type: "player_rejoined_rotation",
+		Content: map[string]interface{}{
+			"user_id": playerID,
+		},
+	})
+	return nil
+}
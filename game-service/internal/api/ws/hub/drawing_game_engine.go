@@ -4,8 +4,10 @@ package hub
 import (
 	"encoding/json"
 	"fmt"
+	"game-service/domain"
 	"log"
 	"math/rand"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -20,11 +22,21 @@ type DrawArtData struct {
 	RoundHistory   map[int]RoundRecord // Tur Numarası -> O turdaki TÜM vuruşlar
 	CurrentStrokes []DrawingStroke     // Mevcut turda yapılan vuruşlar
 	GuessedPlayers map[uuid.UUID]bool
+	AfkStrikes     map[uuid.UUID]int  // Oyuncunun üst üste AFK geçen çizim turu sayısı
+	Benched        map[uuid.UUID]bool // AFK olduğu için çizer sırasından çıkarılan oyuncular
+	WordPool       []SubmittedWord    `json:"-"` // Henüz çizilmemiş oyuncu kelimeleri
+
+	idleTimer   *time.Timer // Çizer hareketsizlik zamanlayıcısı
+	roundEnding bool        // Tur pas/AFK ile bitirilmek üzere; tekrar bitirilmesini engeller
 }
 type RoundRecord struct {
-	Word       string
-	DrawerID   uuid.UUID       // Bu turda kimin çizdiği
-	AllStrokes []DrawingStroke // Bu turdaki tüm vuruşlar (zaten saklıyor olabilirsiniz)
+	Word        string
+	DrawerID    uuid.UUID       // Bu turda kimin çizdiği
+	SubmittedBy uuid.UUID       // Kelimeyi yazan oyuncu (sunucu listesinden geldiyse uuid.Nil)
+	AllStrokes  []DrawingStroke // Bu turdaki tüm vuruşlar (zaten saklıyor olabilirsiniz)
+	StartedAt   time.Time
+	Guesses     []domain.GuessRecord // Doğru tahminler, geliş sırasıyla
+	Points      map[uuid.UUID]int    // Bu turda oyuncuların kazandığı puanlar
 }
 type DrawingStroke struct {
 	PlayerID uuid.UUID // Bu vuruşu yapan oyuncu
@@ -57,7 +69,14 @@ func (dge *DrawingGameEngine) InitGame(game *Game, players []*Player) error {
 		RoundHistory:   make(map[int]RoundRecord), // Geçmişi saklamak için map oluştur
 		CurrentStrokes: []DrawingStroke{},
 		GuessedPlayers: make(map[uuid.UUID]bool),
+		AfkStrikes:     make(map[uuid.UUID]int),
+		Benched:        make(map[uuid.UUID]bool),
+		WordPool:       make([]SubmittedWord, len(game.WordPool)),
 	}
+	copy(artData.WordPool, game.WordPool)
+	rand.Shuffle(len(artData.WordPool), func(i, j int) {
+		artData.WordPool[i], artData.WordPool[j] = artData.WordPool[j], artData.WordPool[i]
+	})
 	game.ModeData = artData
 
 	log.Printf("Initialized Drawing & Guessing game for room %s. First drawer: %s", game.RoomID, game.ActivePlayer)
@@ -106,6 +125,9 @@ func (dge *DrawingGameEngine) ProcessMove(game *Game, playerID uuid.UUID, moveDa
 			PlayerID: playerID,
 			Data:     string(jsonData),
 		})
+		// Çizer aktif: AFK sayacı sıfırlanır ve hareketsizlik süresi baştan başlar
+		delete(drawingData.AfkStrikes, playerID)
+		dge.armIdleTimer(game, drawingData)
 		log.Printf("Drawing updated for room %s by player %s", game.RoomID, playerID)
 		dge.gameHub.hub.BroadcastToOthers(game.RoomID, playerID, &Message{
 			Type: "canvas_update",
@@ -132,8 +154,14 @@ func (dge *DrawingGameEngine) ProcessMove(game *Game, playerID uuid.UUID, moveDa
 			return fmt.Errorf("drawer cannot guess the word")
 		}
 
-		// Kelime doğru tahmin edildi mi?
-		if guessText == drawingData.CurrentWord {
+		// Kelimeyi yazan oyuncu kendi kelimesini tahmin edemez
+		submittedBy := drawingData.RoundHistory[game.TurnCount].SubmittedBy
+		if submittedBy != uuid.Nil && playerID == submittedBy {
+			return fmt.Errorf("you cannot guess your own word")
+		}
+
+		// Kelime doğru tahmin edildi mi? (büyük/küçük harf ve fazla boşluklar önemsizdir)
+		if domain.NormalizeWord(guessText) == domain.NormalizeWord(drawingData.CurrentWord) {
 			// Oyuncu zaten bilmiş mi kontrol et
 			if _, alreadyGuessed := drawingData.GuessedPlayers[playerID]; !alreadyGuessed {
 
@@ -145,6 +173,10 @@ func (dge *DrawingGameEngine) ProcessMove(game *Game, playerID uuid.UUID, moveDa
 				// 🎯 KISIM 2: Skor ekleme mantığı: Hem Tahminci hem de Çizer puan kazanır
 				guesserScore := 10       // Tahminci puanı
 				drawerScorePerGuess := 5 // Çizerin her başarılı tahminden aldığı puan
+				submitterScore := 0      // Kelimeyi yazan oyuncunun her doğru tahminden aldığı puan
+				if submittedBy != uuid.Nil {
+					submitterScore = submitterScorePerGuess
+				}
 
 				for _, p := range game.Players {
 					if p.UserID == playerID {
@@ -154,16 +186,34 @@ func (dge *DrawingGameEngine) ProcessMove(game *Game, playerID uuid.UUID, moveDa
 						// Çizer puanı (Her doğru tahminde bir kez alır)
 						p.Score += drawerScorePerGuess
 					}
+					if p.UserID == submittedBy {
+						p.Score += submitterScore
+					}
 				}
+				dge.recordGuess(game, drawingData, playerID, guesserScore, drawerScorePerGuess, submitterScore)
 
 				// Tur Bitiş Kontrolü
 				isRoundOver, _ := dge.CheckRoundStatus(game)
-				if isRoundOver {
+				if isRoundOver && !drawingData.roundEnding {
 					// Tur bittiği için zamanlayıcıyı durdur ve turu bitir
+					drawingData.roundEnding = true
+					drawingData.stopIdleTimer()
 					go dge.gameHub.handleRoundEnd(game.RoomID, "all_guessed")
 				}
 			}
 		}
+	case "skip_turn":
+		drawingData, _ := game.ModeData.(*DrawArtData)
+		if drawingData == nil {
+			return fmt.Errorf("oyun modu verisi eksik veya yanlış tipte")
+		}
+		return dge.skipTurn(game, drawingData, playerID)
+	case "rejoin_rotation":
+		drawingData, _ := game.ModeData.(*DrawArtData)
+		if drawingData == nil {
+			return fmt.Errorf("oyun modu verisi eksik veya yanlış tipte")
+		}
+		return dge.rejoinRotation(game, drawingData, playerID)
 	}
 
 	// Oyun durumu güncellendi, bu durumu yayınlaması için GameHub'ı bilgilendir
@@ -179,7 +229,17 @@ func (dge *DrawingGameEngine) ProcessMove(game *Game, playerID uuid.UUID, moveDa
 func (dge *DrawingGameEngine) CheckRoundStatus(game *Game) (bool, error) {
 	// Tüm oyuncular doğru tahmin ettiyse veya süre bittiyse true döner.
 	drawingData, _ := game.ModeData.(*DrawArtData)
-	return len(drawingData.GuessedPlayers) == len(game.Players)-1, nil
+	expected := len(game.Players) - 1 // Çizer tahmin etmez
+	// Kelimeyi yazan oyuncu kendi kelimesini tahmin edemez; hâlâ oyundaysa beklenmez
+	if submittedBy := drawingData.RoundHistory[game.TurnCount].SubmittedBy; submittedBy != uuid.Nil && submittedBy != game.ActivePlayer {
+		for _, p := range game.Players {
+			if p.UserID == submittedBy {
+				expected--
+				break
+			}
+		}
+	}
+	return len(drawingData.GuessedPlayers) >= expected, nil
 }
 
 // 💡 Yeni Metot: Tur Başlatma ve Rol Bildirimlerini Yönetir
@@ -200,18 +260,23 @@ func (dge *DrawingGameEngine) StartRound(game *Game) error {
 		return fmt.Errorf("mode data is not of expected type CollaborativeArtData")
 	}
 	// 💡 Kelime seçimi burada yapılır: drawingData.CurrentWord = dge.selectRandomWord()
-	selectedWord := dge.selectRandomWord()
+	selectedWord, submittedBy := dge.selectWordFor(drawingData, game.ActivePlayer)
 	drawingData.CurrentWord = selectedWord         // Örnek olarak
 	drawingData.CurrentStrokes = []DrawingStroke{} // Çizimleri sıfırla
 	drawingData.GuessedPlayers = make(map[uuid.UUID]bool)
+	drawingData.roundEnding = false
 	currentRoundNum := game.TurnCount
 	drawingData.RoundHistory[currentRoundNum] = RoundRecord{
 		Word: selectedWord,
 		// ActivePlayer'ın doğru ayarlandığından emin olun!
 		// game.ActivePlayer, bu turu çizecek kişinin ID'si olmalı.
-		DrawerID: game.ActivePlayer,
+		DrawerID:    game.ActivePlayer,
+		SubmittedBy: submittedBy,
 		// AllStrokes şimdilik boş kalabilir, Stroke'lar EndRound'da eklenecektir.
 		AllStrokes: []DrawingStroke{},
+		StartedAt:  time.Now(),
+		Guesses:    []domain.GuessRecord{},
+		Points:     make(map[uuid.UUID]int),
 	}
 	// 3. Bildirimleri Gönder
 	for _, p := range game.Players {
@@ -237,6 +302,19 @@ func (dge *DrawingGameEngine) StartRound(game *Game) error {
 			})
 		}
 	}
+	// Seyirciler kelimeyi görmeden sadece tur bilgisini alır.
+	dge.gameHub.hub.BroadcastToSpectators(game.RoomID, &Message{
+		Type: "round_start_spectator",
+		Content: map[string]interface{}{
+			"drawer_id":    game.ActivePlayer,
+			"round_number": game.TurnCount,
+			"total_rounds": game.TotalRounds,
+			"duration":     game.RoundDuration,
+		},
+	})
+
+	// Çizer belirli bir süre hiç çizmezse tur beklemeden biter
+	dge.armIdleTimer(game, drawingData)
 
 	return nil
 }
@@ -247,6 +325,27 @@ var defaultWordList = []string{
 	"Bisiklet", "Gitar", "Elma", "Yıldız", "Saat",
 }
 
+// submitterScorePerGuess, oyuncunun yazdığı kelime her doğru tahmin edildiğinde ona verilen puandır.
+const submitterScorePerGuess = 3
+
+// UsesSubmittedWords, bu modun oyuncuların yazdığı kelimelerle oynanabildiğini belirtir.
+func (dge *DrawingGameEngine) UsesSubmittedWords() bool {
+	return true
+}
+
+// selectWordFor, çizere oyuncu kelimelerinden kendi yazmadığı bir kelime verir. Uygun kelime
+// kalmadıysa sunucu listesinden seçilir. Seçilen oyuncu kelimesi havuzdan çıkarılır.
+func (dge *DrawingGameEngine) selectWordFor(artData *DrawArtData, drawerID uuid.UUID) (string, uuid.UUID) {
+	for i, candidate := range artData.WordPool {
+		if candidate.SubmittedBy == drawerID {
+			continue
+		}
+		artData.WordPool = append(artData.WordPool[:i], artData.WordPool[i+1:]...)
+		return candidate.Word, candidate.SubmittedBy
+	}
+	return dge.selectRandomWord(), uuid.Nil
+}
+
 func (dge *DrawingGameEngine) selectRandomWord() string {
 	// Kelime listesi boşsa varsayılan bir değer dön
 	if len(defaultWordList) == 0 {
@@ -286,6 +385,10 @@ func (dge *DrawingGameEngine) EndRound(game *Game, reason string) bool {
 
 	// 2. O anki (biten) turun CurrentStrokes verisini kayda ekle
 	record.AllStrokes = artData.CurrentStrokes
+	artData.stopIdleTimer()
+	if reason == RoundEndDrawerAFK {
+		dge.recordAfkRound(game, artData, record.DrawerID)
+	}
 
 	// 3. Güncellenmiş kaydı geri yaz (map'lerde gerekli)
 	artData.RoundHistory[endedRoundNum] = record
@@ -312,6 +415,86 @@ func (dge *DrawingGameEngine) EndRound(game *Game, reason string) bool {
 	return true // Yeni tura geçilmesi gerekiyor
 }
 
+// recordGuess, doğru tahmini ve turda kazanılan puanları oyun sonu özeti için tur kaydına işler.
+// game.Mutex tutulurken çağrılır.
+func (dge *DrawingGameEngine) recordGuess(game *Game, artData *DrawArtData, guesserID uuid.UUID, guesserScore, drawerScore, submitterScore int) {
+	record, exists := artData.RoundHistory[game.TurnCount]
+	if !exists {
+		return
+	}
+	if record.Points == nil {
+		record.Points = make(map[uuid.UUID]int)
+	}
+
+	guess := domain.GuessRecord{
+		Round:     game.TurnCount,
+		UserID:    guesserID,
+		Order:     len(record.Guesses) + 1,
+		ElapsedMs: time.Since(record.StartedAt).Milliseconds(),
+	}
+	for _, p := range game.Players {
+		if p.UserID == guesserID {
+			guess.Username = p.Username
+			break
+		}
+	}
+	record.Guesses = append(record.Guesses, guess)
+	record.Points[guesserID] += guesserScore
+	record.Points[record.DrawerID] += drawerScore
+	if record.SubmittedBy != uuid.Nil && submitterScore > 0 {
+		record.Points[record.SubmittedBy] += submitterScore
+	}
+
+	// Map değer tuttuğu için güncellenen kayıt geri yazılır
+	artData.RoundHistory[game.TurnCount] = record
+}
+
+// RoundSummaries, oynanan turların kelimelerini, çizerlerini ve doğru tahminlerini özet için döner.
+// Oyun bittikten sonra çağrılır; kelimeler artık herkese açıklanabilir.
+func (dge *DrawingGameEngine) RoundSummaries(game *Game) []domain.RoundSummary {
+	artData, ok := game.ModeData.(*DrawArtData)
+	if !ok || artData == nil {
+		return nil
+	}
+
+	return roundSummaries(artData.RoundHistory, game.Players)
+}
+
+// roundSummaries, tur kayıtlarını özet tur listesine çevirir. Çizim tabanlı modlar ortak kullanır.
+func roundSummaries(history map[int]RoundRecord, players []*Player) []domain.RoundSummary {
+	usernames := make(map[uuid.UUID]string, len(players))
+	for _, p := range players {
+		usernames[p.UserID] = p.Username
+	}
+
+	rounds := make([]domain.RoundSummary, 0, len(history))
+	for roundNum, record := range history {
+		guesses := record.Guesses
+		if guesses == nil {
+			guesses = []domain.GuessRecord{}
+		}
+		points := record.Points
+		if points == nil {
+			points = map[uuid.UUID]int{}
+		}
+		var submittedBy *uuid.UUID
+		if record.SubmittedBy != uuid.Nil {
+			id := record.SubmittedBy
+			submittedBy = &id
+		}
+		rounds = append(rounds, domain.RoundSummary{
+			Round:          roundNum,
+			Word:           record.Word,
+			DrawerID:       record.DrawerID,
+			DrawerUsername: usernames[record.DrawerID],
+			SubmittedBy:    submittedBy,
+			Guesses:        guesses,
+			Points:         points,
+		})
+	}
+	return rounds
+}
+
 // getNextDrawer, sıradaki çizerin ID'sini döndürür ve indeksi günceller.
 func (dge *DrawingGameEngine) getNextDrawer(game *Game) uuid.UUID {
 	// NOT: Bu metot EndRound içinden kilitli olarak çağrılacağı için burada kilit koymuyoruz.
@@ -321,8 +504,18 @@ func (dge *DrawingGameEngine) getNextDrawer(game *Game) uuid.UUID {
 		return uuid.Nil
 	}
 
-	// İndeksi bir sonraki oyuncuya ilerlet
+	// İndeksi bir sonraki oyuncuya ilerlet; AFK nedeniyle sıradan çıkarılanlar atlanır.
+	// Herkes sıradan çıkarıldıysa sıra yine de ilerler (oyun kilitlenmesin).
 	nextIndex := (game.CurrentDrawerIndex + 1) % playerCount
+	if artData, ok := game.ModeData.(*DrawArtData); ok {
+		for i := 0; i < playerCount; i++ {
+			candidate := (game.CurrentDrawerIndex + 1 + i) % playerCount
+			if !artData.Benched[game.Players[candidate].UserID] {
+				nextIndex = candidate
+				break
+			}
+		}
+	}
 	game.CurrentDrawerIndex = nextIndex
 
 	// Yeni ActivePlayer'ı döndür
@@ -401,7 +594,9 @@ func (dge *DrawingGameEngine) SendPreparationNotifications(game *Game) {
 	log.Printf("Preparation notifications sent for room %s. Next drawer: %s, Duration: %ds",
 		game.RoomID, nextDrawer, game.PreparationDuration)
 }
-func (dge *DrawingGameEngine) SendFinalArtReport(game *Game) {
+
+// SendFinalArtReport, turların çizim kayıtlarını ve varsa oyun sonu özetini yayınlar.
+func (dge *DrawingGameEngine) SendFinalArtReport(game *Game, summary *domain.GameSummary) {
 	artData, _ := game.ModeData.(*DrawArtData)
 
 	// Nihai rapor yapısı:
@@ -431,12 +626,17 @@ func (dge *DrawingGameEngine) SendFinalArtReport(game *Game) {
 		finalReport[fmt.Sprintf("round_%d", roundNum)] = roundReport
 	}
 
+	content := map[string]interface{}{
+		"rounds": finalReport,
+	}
+	if summary != nil {
+		content["summary"] = summary
+	}
+
 	// Oyun sonu raporunu yayınla
 	dge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
-		Type: "game_over",
-		Content: map[string]interface{}{
-			"rounds": finalReport,
-		},
+		Type:    "game_over",
+		Content: content,
 	})
 
 	log.Printf("Final art report published for room %s.", game.RoomID)
@@ -3,6 +3,7 @@ package hub
 import (
 	"context"
 	"fmt"
+	"game-service/domain"
 	"log"
 	"sync"
 	"time"
@@ -37,33 +38,107 @@ type Player struct {
 }
 
 type GameSettings struct {
-	ModeName            string `json:"mode_name"`
-	ModeID              string `json:"mode_id"`
-	TotalRounds         int    `json:"total_rounds"`
-	RoundDuration       int    `json:"round_duration"` // saniye cinsinden
-	PreparationDuration int    `json:"preparation_duration"`
-	MaxPlayers          int    `json:"max_players"`
-	MinPlayers          int    `json:"min_players"`
+	ModeName            string  `json:"mode_name"`
+	ModeID              string  `json:"mode_id"`
+	TotalRounds         int     `json:"total_rounds"`
+	RoundDuration       int     `json:"round_duration"` // saniye cinsinden
+	PreparationDuration int     `json:"preparation_duration"`
+	MaxPlayers          int     `json:"max_players"`
+	MinPlayers          int     `json:"min_players"`
+	AllowSpectators     bool    `json:"allow_spectators"`     // Oyun sırasında/herkese açık odalarda izleyici kabul edilsin mi
+	SpectatorChat       bool    `json:"spectator_chat"`       // İzleyicilere ayrı sohbet kanalı açılsın mı
+	AllowLateJoin       bool    `json:"allow_late_join"`      // Oyun devam ederken yeni oyuncu katılabilsin mi
+	LateJoinScore       string  `json:"late_join_score"`      // Geç katılanın başlangıç puanı: "zero" veya "min"
+	MaxPauseDuration    int     `json:"max_pause_duration"`   // Bir duraklatmanın en fazla süresi (saniye)
+	PauseTimeoutAction  string  `json:"pause_timeout_action"` // Süre dolunca: "resume" veya "end"
+	VoteKickMajority    float64 `json:"vote_kick_majority"`   // Oylamanın geçmesi için gereken oran (0.5 = salt çoğunluk)
+	VoteKickDuration    int     `json:"vote_kick_duration"`   // Oylama süresi (saniye)
+	VoteKickCooldown    int     `json:"vote_kick_cooldown"`   // Aynı oyuncunun yeni oylama başlatabilmesi için bekleme (saniye)
+	RequireReady        bool    `json:"require_ready"`        // Oyun başlatmak için en az MinPlayers oyuncunun hazır olması gereksin mi
+	AutoStartCountdown  int     `json:"auto_start_countdown"` // Herkes hazır olunca otomatik başlatma geri sayımı (saniye, 0 = kapalı)
+	SkipPenalty         int     `json:"skip_penalty"`         // Sırasını pas geçen çizerden düşülecek puan
+	DrawerIdleTimeout   int     `json:"drawer_idle_timeout"`  // Çizer bu kadar saniye çizmezse tur "drawer_afk" ile biter (0 = kapalı)
+	AfkRoundsLimit      int     `json:"afk_rounds_limit"`     // Üst üste bu kadar AFK turdan sonra çizer sırasından çıkarılır (0 = kapalı)
+	WordSource          string  `json:"word_source"`          // Kelimeler: "builtin" (sunucu listesi) veya "players" (oyuncular yazar)
+	WordsPerPlayer      int     `json:"words_per_player"`     // Kelime toplama aşamasında oyuncu başına kelime sayısı
+	WordSubmissionTime  int     `json:"word_submission_time"` // Kelime toplama aşamasının süresi (saniye)
+	TeamCount           int     `json:"team_count"`           // Takım modunda takım sayısı
+	TeamAssignment      string  `json:"team_assignment"`      // Takımlar: "auto" (dengeli dağıtım) veya "host" (host seçer)
+	StealDelay          int     `json:"steal_delay"`          // Rakip takımların tahmine katılabilmesi için beklenen süre (saniye, 0 = kapalı)
+	DescribeDuration    int     `json:"describe_duration"`    // Kırık Telefon modunda yazma/anlatma aşamalarının süresi (saniye)
+	VotingDuration      int     `json:"voting_duration"`      // Çizim Yarışması modunda oylama aşamasının süresi (saniye)
+	CanvasLayout        string  `json:"canvas_layout"`        // Ortak Alan modunda tuval düzeni: "free", "tiles" veya "layers"
 }
 
+// Geç katılan oyuncunun başlangıç puanı seçenekleri.
+const (
+	LateJoinScoreZero = domain.LateJoinScoreZero
+	LateJoinScoreMin  = domain.LateJoinScoreMin
+)
+
 // Game, bir oyunun mevcut durumunu tutar.
 type Game struct {
-	RoomID              uuid.UUID   `json:"room_id"`
-	ModeName            string      `json:"mode_name"`
-	ModeID              string      `json:"mode_id"`
-	State               string      `json:"state"`
-	Players             []*Player   `json:"players"`
-	TurnCount           int         `json:"turn_count"`
-	TotalRounds         int         `json:"total_rounds"`
-	RoundDuration       int         `json:"round_duration"`
-	ActivePlayer        uuid.UUID   `json:"active_player"`
-	LastMoveTime        time.Time   `json:"last_move_time"`
-	PreparationDuration int         `json:"preparation_duration"` // 🎯 YENİ
-	ModeData            interface{} `json:"mode_data"`
-	CurrentDrawerIndex  int         `json:"current_drawer_index"`
+	RoomID              uuid.UUID         `json:"room_id"`
+	ModeName            string            `json:"mode_name"`
+	ModeID              string            `json:"mode_id"`
+	State               string            `json:"state"`
+	Players             []*Player         `json:"players"`
+	TurnCount           int               `json:"turn_count"`
+	TotalRounds         int               `json:"total_rounds"`
+	RoundDuration       int               `json:"round_duration"`
+	ActivePlayer        uuid.UUID         `json:"active_player"`
+	LastMoveTime        time.Time         `json:"last_move_time"`
+	PreparationDuration int               `json:"preparation_duration"` // 🎯 YENİ
+	ModeData            interface{}       `json:"mode_data"`
+	CurrentDrawerIndex  int               `json:"current_drawer_index"`
+	IsPaused            bool              `json:"is_paused"`
+	PausedAt            time.Time         `json:"paused_at,omitempty"`
+	PausedRemaining     int               `json:"paused_remaining"` // Duraklatıldığında turda kalan süre (saniye)
+	StartedAt           time.Time         `json:"started_at"`
+	SkipPenalty         int               `json:"skip_penalty"`
+	DrawerIdleTimeout   int               `json:"drawer_idle_timeout"`
+	AfkRoundsLimit      int               `json:"afk_rounds_limit"`
+	WordPool            []SubmittedWord   `json:"-"` // Oyuncuların yazdığı kelimeler (boşsa sunucu listesi kullanılır)
+	TeamCount           int               `json:"team_count"`
+	StealDelay          int               `json:"steal_delay"`
+	DescribeDuration    int               `json:"describe_duration"`
+	VotingDuration      int               `json:"voting_duration"`
+	CanvasLayout        string            `json:"canvas_layout"`
+	TeamAssignments     map[uuid.UUID]int `json:"-"` // Host'un seçtiği takımlar (oyuncu -> takım numarası)
 	Mutex               sync.RWMutex
 }
 
+// snapshot, oyunun kilit içermeyen bir kopyasını döndürür (yayınlamak için).
+func (game *Game) snapshot() *Game {
+	return &Game{
+		RoomID:              game.RoomID,
+		ModeName:            game.ModeName,
+		ModeID:              game.ModeID,
+		State:               game.State,
+		Players:             game.Players,
+		TurnCount:           game.TurnCount,
+		TotalRounds:         game.TotalRounds,
+		RoundDuration:       game.RoundDuration,
+		ActivePlayer:        game.ActivePlayer,
+		LastMoveTime:        game.LastMoveTime,
+		PreparationDuration: game.PreparationDuration,
+		ModeData:            game.ModeData,
+		CurrentDrawerIndex:  game.CurrentDrawerIndex,
+		IsPaused:            game.IsPaused,
+		PausedAt:            game.PausedAt,
+		PausedRemaining:     game.PausedRemaining,
+		StartedAt:           game.StartedAt,
+		SkipPenalty:         game.SkipPenalty,
+		DrawerIdleTimeout:   game.DrawerIdleTimeout,
+		AfkRoundsLimit:      game.AfkRoundsLimit,
+		TeamCount:           game.TeamCount,
+		StealDelay:          game.StealDelay,
+		DescribeDuration:    game.DescribeDuration,
+		VotingDuration:      game.VotingDuration,
+		CanvasLayout:        game.CanvasLayout,
+	}
+}
+
 type CommonAreaGameData struct {
 	CanvasData string
 }
@@ -79,6 +154,19 @@ type GameHub struct {
 	roundTimers     map[uuid.UUID]context.CancelFunc
 	timerWaitGroups map[uuid.UUID]*sync.WaitGroup
 	roundEndSignal  chan RoundEndSignal
+	// roomID -> aktif turun bitiş zamanı (duraklatmada kalan süreyi hesaplamak için)
+	roundDeadlines map[uuid.UUID]time.Time
+	// roomID -> duraklatma süre sınırı zamanlayıcısının iptal fonksiyonu
+	pauseTimers map[uuid.UUID]context.CancelFunc
+	// rooms.status güncellemeleri sırayla yazılsın diye kuyruk
+	roomStatusUpdates chan roomStatusUpdate
+	// Oyun sonrası rövanş oylamaları ve rövanşlar boyunca biriken oturum sıralamaları
+	postGames    map[uuid.UUID]*postGame
+	standings    map[uuid.UUID]*sessionStandings
+	rematchMutex sync.Mutex
+	// Oyun öncesi oyuncu kelimesi toplama aşamaları
+	wordSubmissions map[uuid.UUID]*wordSubmission
+	wordMutex       sync.Mutex
 
 	mutex sync.RWMutex
 }
@@ -95,15 +183,27 @@ func NewGameHub(hub *Hub) *GameHub {
 		gameEngines:     make(map[string]IGameEngine),
 		roundTimers:     make(map[uuid.UUID]context.CancelFunc),
 		timerWaitGroups: make(map[uuid.UUID]*sync.WaitGroup),
+		roundDeadlines:  make(map[uuid.UUID]time.Time),
+		pauseTimers:     make(map[uuid.UUID]context.CancelFunc),
 		roundEndSignal:  make(chan RoundEndSignal, 5),
+
+		roomStatusUpdates: make(chan roomStatusUpdate, 100),
+		postGames:         make(map[uuid.UUID]*postGame),
+		standings:         make(map[uuid.UUID]*sessionStandings),
+		wordSubmissions:   make(map[uuid.UUID]*wordSubmission),
 	}
 
 	// gameHub.gameEngines["Çizim ve Tahmin"] = NewDrawingGameEngine(gameHub)
+	// Anahtarlar game_modes tablosuna sabit ID'lerle eklenen modlardır (bkz. infra/postgres insertGameModes)
 	gameHub.gameEngines["1"] = NewDrawingGameEngine(gameHub)
 	gameHub.gameEngines["2"] = NewCollaborativeArtEngine(gameHub)
+	gameHub.gameEngines["4"] = NewTeamGameEngine(gameHub)
+	gameHub.gameEngines["5"] = NewTelephoneGameEngine(gameHub)
+	gameHub.gameEngines["6"] = NewContestGameEngine(gameHub)
 	// gameHub.gameEngines["Ortak Alan"] = NewDrawingGameEngine(gameHub)
 	// gameHub.gameEngines["serbest çizim"] = NewDrawingGameEngine(gameHub)
 	go gameHub.RunListener()
+	go gameHub.runRoomStatusWorker()
 	return gameHub
 }
 
@@ -313,6 +413,9 @@ func (g *GameHub) handleDisconnectWithGracePeriod(roomID, userID uuid.UUID, wasA
 
 	g.mutex.Unlock()
 
+	// Süresi dolan oyuncu host ise sahipliği bağlı bir oyuncuya devret
+	go g.hub.migrateHostIfNeeded(roomID, userID)
+
 	// Kalan oyuncu sayısı yetersiz mi?
 	if remainingPlayerCount < minPlayers {
 		log.Printf("Insufficient players (%d < %d). Ending game for room %s",
@@ -372,6 +475,7 @@ func (g *GameHub) startRoundTimer(roomID uuid.UUID, duration time.Duration) {
 	// Haritalara kaydet
 	g.mutex.Lock()
 	g.roundTimers[roomID] = cancel
+	g.roundDeadlines[roomID] = time.Now().Add(duration)
 	g.timerWaitGroups[roomID] = wg
 	g.mutex.Unlock()
 	log.Printf("START_TIMER: Goroutine started for room %s, duration: %v", roomID, duration)
@@ -412,6 +516,7 @@ func (g *GameHub) stopRoundTimer(roomID uuid.UUID) {
 
 	delete(g.roundTimers, roomID)
 	delete(g.timerWaitGroups, roomID)
+	delete(g.roundDeadlines, roomID)
 	g.mutex.Unlock() // Kilit serbest bırakıldı.
 
 	// 2. İptal Sinyalini Gönder
@@ -459,6 +564,12 @@ func (g *GameHub) handleRoundEnd(roomID uuid.UUID, reason string) {
 		return // Oyun zaten bitmiş olabilir
 	}
 	log.Printf("Round ended for room %s. Reason: %s", roomID, reason)
+	game.Mutex.Lock()
+	if game.IsPaused {
+		// Duraklatılmış oyunda tur başka bir sebeple bittiyse (ör. çizer ayrıldı) duraklatmayı kaldır.
+		g.clearPauseLocked(roomID, game)
+	}
+	game.Mutex.Unlock()
 
 	engine, ok := g.gameEngines[game.ModeID]
 	if !ok {
@@ -477,7 +588,7 @@ func (g *GameHub) handleRoundEnd(roomID uuid.UUID, reason string) {
 	// Game kilidini serbest bırak (çok önemli!).
 	game.Mutex.Unlock()
 	log.Printf("HANDLE_ROUND_END: EndRound finished for room %s. Should continue: %v", roomID, shouldContinue)
-	gameSnapshot := *game
+	gameSnapshot := game.snapshot()
 	var cleanModeData interface{}
 	if game.ModeID == "2" {
 		artData, ok := game.ModeData.(*CollaborativeArtData)
@@ -487,6 +598,7 @@ func (g *GameHub) handleRoundEnd(roomID uuid.UUID, reason string) {
 				CurrentWord:    artData.CurrentWord,
 				CurrentStrokes: artData.CurrentStrokes, // Bu zaten boş olmalı
 				RoundHistory:   nil,                    // 🔑 ÖNEMLİ: Geçmişi gönderme!
+				Layout:         artData.Layout,
 			}
 			gameSnapshot.ModeData = cleanModeData
 		}
@@ -544,6 +656,9 @@ func (g *GameHub) handleRoundEnd(roomID uuid.UUID, reason string) {
 		gameOverContent["scores"] = game.Players // Skorları her zaman göndermek kötü değil.
 		gameOverContent["data"] = game.ModeData
 
+		// Sıralama ve tur istatistikleri (kalıcı olarak da saklanır)
+		summary := g.buildGameSummary(game, engine, true)
+
 		// 🎯 KRİTİK DEĞİŞİKLİK: Sadece DrawingGameEngine gibi puanlamalı modlar için kazananı belirle.
 		if game.ModeID == "1" {
 			// Motoru somut tipine dönüştürmemiz GEREKİYOR, çünkü determineWinner IGameEngine'de yok.
@@ -551,7 +666,7 @@ func (g *GameHub) handleRoundEnd(roomID uuid.UUID, reason string) {
 			dge, ok := engine.(*DrawingGameEngine)
 			if ok {
 				gameOverContent["winner"] = dge.determineWinner(game)
-				dge.SendFinalArtReport(game)
+				dge.SendFinalArtReport(game, summary)
 			}
 		} else if game.ModeID == "2" {
 			// CollaborativeArtEngine'e özel bir "Oyun Bitti" aksiyonu varsa çağır.
@@ -568,6 +683,24 @@ func (g *GameHub) handleRoundEnd(roomID uuid.UUID, reason string) {
 			// Ortak Sanat Projesinde kazanan yerine sadece final rapor bilgisi gönderilir.
 			gameOverContent["message"] = "Ortak Sanat Projesi Tamamlandı. Lütfen Raporu kontrol edin."
 
+		} else if game.ModeID == "4" {
+			// Takım modunda takım puanları ve bireysel katkılar birlikte gönderilir.
+			tge, ok := engine.(*TeamGameEngine)
+			if ok {
+				tge.SendGameOver(game, summary)
+			}
+		} else if game.ModeID == "5" {
+			// Kırık Telefon'da puan yoktur; zincirler adım adım açılır.
+			tge, ok := engine.(*TelephoneGameEngine)
+			if ok {
+				tge.SendChainReveal(game, summary)
+			}
+		} else if game.ModeID == "6" {
+			// Çizim Yarışmasında tur sonuçları oylardan gelir.
+			cge, ok := engine.(*ContestGameEngine)
+			if ok {
+				cge.SendGameOver(game, summary)
+			}
 		}
 
 		// Oyun Bitti mesajını yayınla.
@@ -576,9 +709,19 @@ func (g *GameHub) handleRoundEnd(roomID uuid.UUID, reason string) {
 		// 	Content: gameOverContent,
 		// })
 
+		g.publishGameSummary(summary)
+
+		// Sonuç ekranını ve rövanş oylamasını aç
+		g.beginPostGame(roomID, game, true)
+
 		// Aktif oyunlardan kaldır.
 		delete(g.activeGames, roomID)
 		// delete(g.roomSettings, roomID)
+		g.setRoomStatus(roomID, domain.RoomStatusFinished)
+		g.scheduleReturnToLobby(roomID)
+
+		// Oyun sırasında bağlanan oda üyeleri bir sonraki oyunda oyuncu olabilir.
+		go g.hub.promoteMemberSpectators(roomID)
 	}
 }
 func (g *GameHub) HandleGameMessage(roomID uuid.UUID, msg RoomManagerData) {
@@ -598,6 +741,10 @@ func (g *GameHub) HandleGameMessage(roomID uuid.UUID, msg RoomManagerData) {
 		g.handlePlayerMove(roomID, msg)
 	case "canvas_action":
 		g.handlePlayerMove(roomID, msg)
+	case "game_pause":
+		g.handleGamePause(roomID, msg)
+	case "game_resume":
+		g.handleGameResume(roomID, msg)
 
 	default:
 		fmt.Printf("GameHub: Bilinmeyen mesaj tipi: %s\n", msg.Type)
@@ -627,19 +774,24 @@ func (g *GameHub) handleGameModeChange(roomID uuid.UUID, msg RoomManagerData) {
 		return
 	}
 
-	// Odanın ayarlarını al veya oluştur
-	settings, exists := g.roomSettings[roomID]
-	if !exists {
-		settings = g.getDefaultSettings(modeID)
-		settings.ModeID = modeID
-		settings.ModeName = modeData["mode_name"].(string)
+	// Mod HTTP üzerinden kaydedildi; ayarları yeni modun sınırlarıyla veritabanından yeniden oku.
+	// Okunamazsa modun katalogdaki varsayılanlarına dönülür.
+	ctx, cancel := context.WithTimeout(g.hub.ctx, settingsLoadTimeout)
+	stored, err := g.hub.repo.GetRoomSettings(ctx, roomID)
+	cancel()
+	var settings *GameSettings
+	if err == nil {
+		settings = gameSettingsFromDomain(stored)
 	} else {
-		settings.ModeID = modeID
-		// Mode değiştiğinde ayarları yeniden hesapla
-		// g.calculateGameSettings(roomID, settings)
+		log.Printf("Failed to reload settings after mode change for room %s: %v", roomID, err)
+		if settings = g.defaultSettings(modeID); settings == nil {
+			return
+		}
 	}
 
+	g.mutex.Lock()
 	g.roomSettings[roomID] = settings
+	g.mutex.Unlock()
 
 	// Oyun modu değişikliğini odadaki herkese bildir
 	response := &Message{
@@ -647,6 +799,7 @@ func (g *GameHub) handleGameModeChange(roomID uuid.UUID, msg RoomManagerData) {
 		Content: map[string]interface{}{
 			"room_id":      roomID,
 			"game_mode_id": modeID,
+			"mode_name":    settings.ModeName,
 		},
 	}
 
@@ -654,7 +807,8 @@ func (g *GameHub) handleGameModeChange(roomID uuid.UUID, msg RoomManagerData) {
 	fmt.Printf("Oyun modu değiştirildi - Room: %s, Mode: %s\n", roomID, modeID)
 }
 
-// handleGameSettingsUpdate, oyun ayarları güncellemesini işler
+// handleGameSettingsUpdate, WS üzerinden gelen ayar güncellemesini doğrulayıp kaydeder.
+// Doğrulama hatası sadece isteği gönderen oyuncuya iletilir.
 func (g *GameHub) handleGameSettingsUpdate(roomID uuid.UUID, msg RoomManagerData) {
 	fmt.Printf("Oyun ayarları güncelleniyor - Room: %s\n", roomID)
 
@@ -664,62 +818,45 @@ func (g *GameHub) handleGameSettingsUpdate(roomID uuid.UUID, msg RoomManagerData
 		return
 	}
 
-	settings, exists := g.roomSettings[roomID]
-	if !exists {
-		// Varsayılan ayarları oluştur
-		settings = &GameSettings{
-			ModeName:            "Çizim ve Tahmin", // default
-			ModeID:              "1",
-			TotalRounds:         2,
-			RoundDuration:       60,
-			PreparationDuration: 5, // 🎯 Varsayılan 5 saniye
-			MaxPlayers:          8,
-			MinPlayers:          2,
-		}
-	}
-
-	// Ayarları güncelle
-	if rounds, ok := settingsData["total_rounds"].(float64); ok {
-		settings.TotalRounds = int(rounds)
-	}
-	if duration, ok := settingsData["round_duration"].(float64); ok {
-		settings.RoundDuration = int(duration)
-	}
-	if maxPlayers, ok := settingsData["max_players"].(float64); ok {
-		settings.MaxPlayers = int(maxPlayers)
-	}
-	if minPlayers, ok := settingsData["min_players"].(float64); ok {
-		settings.MinPlayers = int(minPlayers)
+	actorStr, _ := settingsData["player_id"].(string)
+	actorID, err := uuid.Parse(actorStr)
+	if err != nil {
+		log.Printf("Settings update without a valid player_id for room %s", roomID)
+		return
 	}
 
-	g.roomSettings[roomID] = settings
-
-	// Ayar güncellemesini bildir
-	response := &Message{
-		Type: "game_settings_updated",
-		Content: map[string]interface{}{
-			"max_players":    settings.MaxPlayers,
-			"min_players":    settings.MinPlayers,
-			"game_mode_id":   settings.ModeID,
-			"mode_name":      settings.ModeName,
-			"total_rounds":   settings.TotalRounds,
-			"round_duration": settings.RoundDuration,
-		},
+	ctx, cancel := context.WithTimeout(g.hub.ctx, settingsLoadTimeout)
+	defer cancel()
+	stored, err := g.hub.repo.UpdateRoomSettings(ctx, roomID, actorID, settingsPatchFromContent(settingsData))
+	if err != nil {
+		log.Printf("Settings update rejected for room %s: %v", roomID, err)
+		g.hub.SendMessageToUser(roomID, actorID, &Message{
+			Type:    "error",
+			Content: fmt.Sprintf("Ayarlar güncellenemedi: %v", err),
+		})
+		return
 	}
 
-	g.hub.BroadcastMessage(roomID, response)
-	fmt.Printf("Oyun ayarları güncellendi - Room: %s\n", roomID)
+	g.applyRoomSettings(roomID, stored)
 }
 
 // handleGameStarted, oyun başlatıldığında çağrılır
 func (g *GameHub) handleGameStarted(roomID uuid.UUID, msg RoomManagerData) {
 	fmt.Printf("Oyun başlatılıyor - Room: %s\n", roomID)
+	// startRematch'in ayırması bu istek işlenince kalkar (başarısız olursa rövanş tekrar denenebilir)
+	if content, ok := msg.Content.(map[string]interface{}); ok {
+		if requested, _ := content[rematchFlag].(bool); requested {
+			defer g.releaseRematch(roomID)
+		}
+	}
 
 	g.mutex.RLock()
 	game, gameExists := g.activeGames[roomID]
-	settings, settingsExists := g.roomSettings[roomID]
 	g.mutex.RUnlock() // 🛑 Okuma bitti, GameHub kilidini serbest bırak!
 
+	// Ayarlar önbellekte yoksa (ör. yeniden başlatma sonrası) veritabanından yüklenir
+	settings := g.loadRoomSettings(roomID)
+
 	if gameExists && game.State == GameStateInProgress {
 		fmt.Printf("Oyun zaten devam ediyor. Yeni oyun başlatma isteği reddedildi - Room: %s\n", roomID)
 		// Oyunculara hata mesajı gönder
@@ -735,14 +872,21 @@ func (g *GameHub) handleGameStarted(roomID uuid.UUID, msg RoomManagerData) {
 		return
 	}
 
-	if !settingsExists {
-		fmt.Printf("Oda ayarları bulunamadı, varsayılan ayarlar kullanılıyor - Room: %s\n", roomID)
-		settings = g.getDefaultSettings("Çizim ve Tahmin")
-
+	if settings == nil {
+		fmt.Printf("Oda ayarları yüklenemedi - Room: %s\n", roomID)
+		g.hub.BroadcastMessage(roomID, &Message{
+			Type: "game_start_failed",
+			Content: map[string]interface{}{
+				"room_id": roomID,
+				"reason":  "settings_unavailable",
+				"message": "Oda ayarları yüklenemedi, lütfen tekrar deneyin.",
+			},
+		})
+		return
 	}
 
-	// Odadaki oyuncu sayısını kontrol et
-	playerCount := g.hub.GetRoomClientCount(roomID)
+	// Odadaki oyuncu sayısını kontrol et (seyirciler sayılmaz)
+	playerCount := g.hub.GetRoomPlayerCount(roomID)
 	if playerCount < settings.MinPlayers {
 		fmt.Printf("Yetersiz oyuncu sayısı - Room: %s, Mevcut: %d, Minimum: %d\n",
 			roomID, playerCount, settings.MinPlayers)
@@ -760,10 +904,56 @@ func (g *GameHub) handleGameStarted(roomID uuid.UUID, msg RoomManagerData) {
 		return
 	}
 
+	// Rövanşta oylama/host kararı hazır olmanın yerine geçer
+	rematch := false
+	if content, ok := msg.Content.(map[string]interface{}); ok {
+		rematch, _ = content[rematchFlag].(bool)
+	}
+	rematch = rematch && g.inPostGame(roomID)
+
+	// Hazır kontrolü: en az MinPlayers oyuncu hazır olmalı
+	if settings.RequireReady && !rematch {
+		if readyCount := g.hub.ReadyPlayerCount(roomID); readyCount < settings.MinPlayers {
+			g.hub.BroadcastMessage(roomID, &Message{
+				Type: "game_start_failed",
+				Content: map[string]interface{}{
+					"room_id":     roomID,
+					"reason":      "players_not_ready",
+					"ready_count": readyCount,
+					"message":     fmt.Sprintf("Oyunu başlatmak için en az %d oyuncunun hazır olması gerekli", settings.MinPlayers),
+				},
+			})
+			return
+		}
+	}
+
 	// Odadaki oyuncuları al (Bu fonksiyonu Hub'a eklemen gerekecek)
 	players := g.getRoomPlayers(roomID)
 	initialPlayerCount := len(players)
 
+	// Oyuncu kelimeleriyle oynanacaksa önce kelime toplama aşaması açılır; aşama bitince
+	// başlatma isteği aynı içerikle tekrar gelir ve oyun toplanan kelimelerle başlar.
+	var wordPool []SubmittedWord
+	if settings.WordSource == domain.WordSourcePlayers && g.usesSubmittedWords(settings.ModeID) {
+		pool, ready, inProgress := g.takeWordPool(roomID)
+		if inProgress {
+			g.hub.BroadcastMessage(roomID, &Message{
+				Type: "game_start_failed",
+				Content: map[string]interface{}{
+					"room_id": roomID,
+					"reason":  "word_submission_in_progress",
+					"message": "Kelime toplama devam ediyor.",
+				},
+			})
+			return
+		}
+		if !ready {
+			g.startWordSubmission(roomID, players, settings, msg.Content)
+			return
+		}
+		wordPool = pool
+	}
+
 	if settings.TotalRounds < initialPlayerCount {
 		settings.TotalRounds = initialPlayerCount
 	}
@@ -782,6 +972,19 @@ func (g *GameHub) handleGameStarted(roomID uuid.UUID, msg RoomManagerData) {
 		PreparationDuration: settings.PreparationDuration,
 		RoundDuration:       settings.RoundDuration,
 		LastMoveTime:        time.Now(),
+		StartedAt:           time.Now(),
+		SkipPenalty:         settings.SkipPenalty,
+		DrawerIdleTimeout:   settings.DrawerIdleTimeout,
+		AfkRoundsLimit:      settings.AfkRoundsLimit,
+		WordPool:            wordPool,
+		TeamCount:           settings.TeamCount,
+		StealDelay:          settings.StealDelay,
+		DescribeDuration:    settings.DescribeDuration,
+		VotingDuration:      settings.VotingDuration,
+		CanvasLayout:        settings.CanvasLayout,
+	}
+	if settings.TeamAssignment == domain.TeamAssignmentHost {
+		newGame.TeamAssignments = g.hub.lobbyTeamAssignments(roomID)
 	}
 
 	g.mutex.RLock()
@@ -794,16 +997,26 @@ func (g *GameHub) handleGameStarted(roomID uuid.UUID, msg RoomManagerData) {
 
 	if err := engine.InitGame(newGame, players); err != nil {
 		fmt.Printf("Oyun başlatılamadı: %v\n", err)
+		g.hub.BroadcastMessage(roomID, &Message{
+			Type: "game_start_failed",
+			Content: map[string]interface{}{
+				"room_id": roomID,
+				"reason":  "init_failed",
+				"message": err.Error(),
+			},
+		})
 		return
 	}
 
 	// Aktif oyunlar listesine ekle
 	g.mutex.Lock()
 	g.activeGames[roomID] = newGame
-	if !settingsExists {
-		g.roomSettings[roomID] = settings // 🛑 Ayar yoksa, onu da kaydet
-	}
 	g.mutex.Unlock()
+	g.setRoomStatus(roomID, domain.RoomStatusPlaying)
+	// Sonuç ekranı kapanır; oturum sıralaması bir sonraki oyuna taşınır
+	g.endPostGame(roomID)
+	// Bir sonraki oyun için hazır durumları sıfırla
+	g.hub.resetLobby(roomID)
 	// Oyun başladı mesajını tüm oyunculara gönder
 	response := &Message{
 		Type: "game_started",
@@ -817,6 +1030,7 @@ func (g *GameHub) handleGameStarted(roomID uuid.UUID, msg RoomManagerData) {
 			"initial_player_count": initialPlayerCount,
 			"preparation_duration": newGame.PreparationDuration,
 			"current_round":        1,
+			"rematch":              rematch,
 		},
 	}
 	g.hub.BroadcastMessage(roomID, response) // 💡 İLK MESAJ GİTTİ!
@@ -852,41 +1066,6 @@ func (g *GameHub) handleGameStarted(roomID uuid.UUID, msg RoomManagerData) {
 		roomID, settings.ModeName, len(players))
 }
 
-// getDefaultSettings, oyun moduna göre varsayılan ayarları döner
-func (g *GameHub) getDefaultSettings(modeName string) *GameSettings {
-	switch modeName {
-	case "Çizim ve Tahmin":
-		return &GameSettings{
-			ModeName:            modeName,
-			ModeID:              "1",
-			TotalRounds:         2, // Her oyuncu 2 kez çizer
-			RoundDuration:       60,
-			MaxPlayers:          8,
-			PreparationDuration: 5,
-			MinPlayers:          2,
-		}
-	case "Ortak Alan":
-		return &GameSettings{
-			ModeName:            modeName,
-			ModeID:              "2",
-			TotalRounds:         1,
-			RoundDuration:       120, //2 dakika
-			PreparationDuration: 5,
-			MaxPlayers:          10,
-			MinPlayers:          2,
-		}
-	default:
-		return &GameSettings{
-			ModeName:            "Çizim ve Tahmin",
-			TotalRounds:         2,
-			RoundDuration:       60,
-			PreparationDuration: 5,
-			MaxPlayers:          8,
-			MinPlayers:          2,
-		}
-	}
-}
-
 // calculateGameSettings, oda durumuna göre ayarları hesaplar
 // func (g *GameHub) calculateGameSettings(roomID uuid.UUID, settings *GameSettings) {
 // 	playerCount := g.hub.GetRoomClientCount(roomID)
@@ -914,6 +1093,10 @@ func (g *GameHub) getRoomPlayers(roomID uuid.UUID) []*Player {
 
 	// Hub'dan gelen her Client nesnesini bir Player nesnesine dönüştür
 	for _, client := range roomClients {
+		// Seyirciler oyuna dahil edilmez.
+		if client.IsSpectator {
+			continue
+		}
 		// NOT: Client nesnesinde sadece ID var.
 		// Eğer Username/Kullanıcı Adı bilgisini client nesnesinde veya veritabanında tutuyorsanız,
 		// onu kullanmalısınız.
@@ -953,6 +1136,85 @@ func (g *GameHub) playersToMap(players []*Player) []map[string]interface{} {
 	return result
 }
 
+// AddLateJoiner, devam eden oyuna yeni bir oyuncu ekler. Oyuncu çizer sırasının sonuna
+// eklenir (bir sonraki turlardan itibaren çizer), puanı ayara göre sıfır ya da
+// mevcut en düşük puan olur ve Çizim ve Tahmin modunda toplam tur sayısı herkesin
+// eşit sayıda çizeceği şekilde yeniden hesaplanır.
+func (g *GameHub) AddLateJoiner(roomID, userID uuid.UUID) (*Game, error) {
+	// game.Players grace period ve IsPlayerInActiveGame tarafından g.mutex altında da okunup
+	// yeniden yazıldığı için ekleme iki kilit altında yapılır (sıra: g.mutex -> game.Mutex).
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	game, exists := g.activeGames[roomID]
+	settings := g.roomSettings[roomID]
+
+	if !exists || game.State != GameStateInProgress {
+		return nil, fmt.Errorf("no active game in room %s", roomID)
+	}
+	if settings == nil || !settings.AllowLateJoin {
+		return nil, fmt.Errorf("late join is not allowed in room %s", roomID)
+	}
+	// Kırık Telefon'da zincir sırası oyun başında sabitlenir; geç gelen oyuncuya görev düşmez
+	if game.ModeID == "5" {
+		return nil, fmt.Errorf("late join is not supported in telephone mode (room %s)", roomID)
+	}
+
+	game.Mutex.Lock()
+	defer game.Mutex.Unlock()
+
+	for _, p := range game.Players {
+		if p.UserID == userID {
+			return game, nil // Zaten oyunda
+		}
+	}
+	if settings.MaxPlayers > 0 && len(game.Players) >= settings.MaxPlayers {
+		return nil, fmt.Errorf("game in room %s is full", roomID)
+	}
+
+	startScore := 0
+	if settings.LateJoinScore == LateJoinScoreMin && len(game.Players) > 0 {
+		startScore = game.Players[0].Score
+		for _, p := range game.Players[1:] {
+			if p.Score < startScore {
+				startScore = p.Score
+			}
+		}
+	}
+
+	// Yeni oyuncu sıranın sonuna eklendiği için mevcut döngüden itibaren her döngüde bir kez çizer.
+	// Toplam tura sadece onun çizim turları eklenir; diğer oyuncuların çizim sayısı değişmez.
+	if game.ModeID == "1" && len(game.Players) > 0 {
+		cycles := (game.TotalRounds + len(game.Players) - 1) / len(game.Players)
+		completedCycles := (game.TurnCount - 1) / len(game.Players)
+		game.TotalRounds += max(cycles-completedCycles, 0)
+	}
+
+	player := &Player{
+		UserID:      userID,
+		Username:    fmt.Sprintf("User-%s", userID.String()[:4]),
+		Score:       startScore,
+		IsConnected: true,
+	}
+	game.Players = append(game.Players, player)
+
+	log.Printf("Late joiner %s added to game in room %s with score %d. Total rounds: %d",
+		userID, roomID, startScore, game.TotalRounds)
+
+	g.hub.BroadcastMessage(roomID, &Message{
+		Type: "player_late_joined",
+		Content: map[string]interface{}{
+			"room_id":      roomID,
+			"user_id":      userID,
+			"username":     player.Username,
+			"score":        player.Score,
+			"total_rounds": game.TotalRounds,
+			"players":      g.playersToMap(game.Players),
+		},
+	})
+
+	return game, nil
+}
+
 // IsGameActive, odada aktif oyun olup olmadığını kontrol eder
 func (g *GameHub) IsGameActive(roomID uuid.UUID) bool {
 	g.mutex.RLock()
@@ -983,6 +1245,22 @@ func (g *GameHub) handlePlayerMove(roomID uuid.UUID, msg RoomManagerData) {
 		return
 	}
 
+	if game.IsPaused {
+		log.Printf("PLAYER_MOVE_FAIL: Room %s, Game is paused.", roomID)
+		if moveData, ok := msg.Content.(map[string]interface{}); ok {
+			if playerID, err := uuid.Parse(fmt.Sprint(moveData["player_id"])); err == nil {
+				g.hub.SendMessageToUser(roomID, playerID, &Message{
+					Type: "move_rejected",
+					Content: map[string]interface{}{
+						"reason":  "game_paused",
+						"message": "Oyun duraklatıldı, hamle yapılamaz.",
+					},
+				})
+			}
+		}
+		return
+	}
+
 	// Mesajın içeriğinden PlayerID'yi al
 	moveData, ok := msg.Content.(map[string]interface{})
 	if !ok {
@@ -1031,6 +1309,9 @@ func (g *GameHub) handleEndGame(roomID uuid.UUID, msg RoomManagerData) {
 
 	// Oyun durumunu güncelle
 	game.State = GameStateOver
+	g.clearPauseLocked(roomID, game)
+
+	engine := g.gameEngines[game.ModeID]
 
 	// Oyunu aktif oyunlardan ve ayarlardan kaldır
 	delete(g.activeGames, roomID)
@@ -1057,6 +1338,12 @@ func (g *GameHub) handleEndGame(roomID uuid.UUID, msg RoomManagerData) {
 	})
 
 	log.Printf("Game ended for room %s. Reason: %s", roomID, reason)
+	// Erken biten oyunda kazanan yoktur; oynanan turların özeti yine de saklanır
+	g.publishGameSummary(g.buildGameSummary(game, engine, false))
+	g.beginPostGame(roomID, game, false)
+	g.setRoomStatus(roomID, domain.RoomStatusFinished)
+	g.scheduleReturnToLobby(roomID)
+	g.hub.promoteMemberSpectators(roomID)
 
 	// Diğer işlemler...
 }
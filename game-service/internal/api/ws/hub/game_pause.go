@@ -0,0 +1,187 @@
+package hub
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Duraklatma süresi dolduğunda yapılacak işlem.
+const (
+	PauseTimeoutResume = domain.PauseTimeoutResume
+	PauseTimeoutEnd    = domain.PauseTimeoutEnd
+)
+
+// handleGamePause, host'un isteğiyle devam eden turu duraklatır. Tur zamanlayıcısı
+// durdurulur ve kalan süre oyunda saklanır; devam edildiğinde bu süreden başlanır.
+func (g *GameHub) handleGamePause(roomID uuid.UUID, msg RoomManagerData) {
+	g.mutex.RLock()
+	game, exists := g.activeGames[roomID]
+	deadline, timerRunning := g.roundDeadlines[roomID]
+	settings := g.roomSettings[roomID]
+	g.mutex.RUnlock()
+
+	if !exists || game.State != GameStateInProgress {
+		log.Printf("GAME_PAUSE_FAIL: No active game in room %s", roomID)
+		return
+	}
+
+	game.Mutex.RLock()
+	alreadyPaused := game.IsPaused
+	game.Mutex.RUnlock()
+	if alreadyPaused {
+		return
+	}
+
+	// Sadece tur oynanırken duraklatılabilir (hazırlık aşamasında zamanlayıcı yoktur).
+	if !timerRunning {
+		g.sendPauseError(roomID, msg, "Oyun şu anda duraklatılamaz, yeni tur başlamak üzere.")
+		return
+	}
+
+	remaining := time.Until(deadline)
+	if remaining < time.Second {
+		remaining = time.Second
+	}
+
+	g.stopRoundTimer(roomID)
+
+	game.Mutex.Lock()
+	game.IsPaused = true
+	game.PausedAt = time.Now()
+	game.PausedRemaining = int(remaining.Round(time.Second) / time.Second)
+	pausedRemaining := game.PausedRemaining
+	game.Mutex.Unlock()
+
+	maxPause := 0
+	timeoutAction := PauseTimeoutResume
+	if settings != nil {
+		maxPause = settings.MaxPauseDuration
+		if settings.PauseTimeoutAction != "" {
+			timeoutAction = settings.PauseTimeoutAction
+		}
+	}
+	if maxPause > 0 {
+		g.startPauseTimer(roomID, time.Duration(maxPause)*time.Second, timeoutAction)
+	}
+
+	g.hub.BroadcastMessage(roomID, &Message{
+		Type: "game_paused",
+		Content: map[string]interface{}{
+			"room_id":            roomID,
+			"paused_by":          pausedBy(msg),
+			"remaining_time":     pausedRemaining,
+			"max_pause_duration": maxPause,
+			"message":            "Oyun host tarafından duraklatıldı.",
+		},
+	})
+	log.Printf("Game paused in room %s. Remaining: %ds", roomID, pausedRemaining)
+}
+
+// handleGameResume, duraklatılmış oyunu kalan süreden devam ettirir.
+func (g *GameHub) handleGameResume(roomID uuid.UUID, msg RoomManagerData) {
+	g.resumeGame(roomID, pausedBy(msg))
+}
+
+func (g *GameHub) resumeGame(roomID uuid.UUID, resumedBy string) {
+	g.mutex.Lock()
+	game, exists := g.activeGames[roomID]
+	if !exists || game.State != GameStateInProgress {
+		g.mutex.Unlock()
+		return
+	}
+
+	game.Mutex.Lock()
+	if !game.IsPaused {
+		game.Mutex.Unlock()
+		g.mutex.Unlock()
+		return
+	}
+	remaining := game.PausedRemaining
+	g.clearPauseLocked(roomID, game)
+	game.Mutex.Unlock()
+	g.mutex.Unlock()
+
+	g.startRoundTimer(roomID, time.Duration(remaining)*time.Second)
+
+	g.hub.BroadcastMessage(roomID, &Message{
+		Type: "game_resumed",
+		Content: map[string]interface{}{
+			"room_id":        roomID,
+			"resumed_by":     resumedBy,
+			"remaining_time": remaining,
+		},
+	})
+	log.Printf("Game resumed in room %s with %ds remaining", roomID, remaining)
+}
+
+// clearPauseLocked, duraklatma durumunu ve süre sınırı zamanlayıcısını temizler.
+// Çağıran g.mutex ve game.Mutex kilitlerini tutmalıdır.
+func (g *GameHub) clearPauseLocked(roomID uuid.UUID, game *Game) {
+	if cancel, ok := g.pauseTimers[roomID]; ok {
+		cancel()
+		delete(g.pauseTimers, roomID)
+	}
+	game.IsPaused = false
+	game.PausedAt = time.Time{}
+	game.PausedRemaining = 0
+}
+
+// startPauseTimer, duraklatma süresi sınırı aşıldığında oyunu otomatik olarak
+// devam ettirir veya sonlandırır.
+func (g *GameHub) startPauseTimer(roomID uuid.UUID, limit time.Duration, action string) {
+	ctx, cancel := context.WithTimeout(context.Background(), limit)
+
+	g.mutex.Lock()
+	if oldCancel, ok := g.pauseTimers[roomID]; ok {
+		oldCancel()
+	}
+	g.pauseTimers[roomID] = cancel
+	g.mutex.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		if ctx.Err() != context.DeadlineExceeded {
+			return // Oyun elle devam ettirildi veya bitti
+		}
+
+		log.Printf("PAUSE_TIMEOUT: Pause limit reached for room %s. Action: %s", roomID, action)
+		if action == PauseTimeoutEnd {
+			g.handleEndGame(roomID, RoomManagerData{
+				Type: "end_game",
+				Content: map[string]interface{}{
+					"room_id": roomID,
+					"reason":  "pause_timeout",
+					"message": fmt.Sprintf("Oyun %v boyunca duraklatıldığı için sonlandırıldı.", limit),
+				},
+			})
+			return
+		}
+		g.resumeGame(roomID, "system")
+	}()
+}
+
+func (g *GameHub) sendPauseError(roomID uuid.UUID, msg RoomManagerData, text string) {
+	playerID, err := uuid.Parse(pausedBy(msg))
+	if err != nil {
+		return
+	}
+	g.hub.SendMessageToUser(roomID, playerID, &Message{
+		Type:    "error",
+		Content: text,
+	})
+}
+
+// pausedBy, mesajı gönderen oyuncunun ID'sini döndürür (readPump tarafından eklenir).
+func pausedBy(msg RoomManagerData) string {
+	if content, ok := msg.Content.(map[string]interface{}); ok {
+		if playerID, ok := content["player_id"].(string); ok {
+			return playerID
+		}
+	}
+	return ""
+}
@@ -0,0 +1,150 @@
+package hub
+
+import (
+	"encoding/json"
+	"game-service/domain"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// Bağlanan client'lara gönderilen oyun kopyaları game.Mutex altında hazırlanır ve kilit
+// bırakıldıktan sonra JSON'a çevrilir. Bu yüzden canlı oyunla hiçbir slice ya da map paylaşılmaz;
+// aksi halde ProcessMove aynı anda yazarken eşzamanlı map okuması süreci çökertebilir.
+
+// SpectatorSnapshot, seyirciye gönderilecek kopyayı döndürür. Gizli kelime ve tur geçmişi
+// ModeData'dan çıkarılır; seyirci sadece mevcut turun çizimini görür.
+func (game *Game) SpectatorSnapshot() *Game {
+	game.Mutex.RLock()
+	defer game.Mutex.RUnlock()
+
+	gameSnapshot := game.snapshot()
+	gameSnapshot.Players = copyPlayers(game.Players)
+	switch data := game.ModeData.(type) {
+	case *DrawArtData:
+		gameSnapshot.ModeData = &DrawArtData{
+			CurrentStrokes: copyStrokes(data.CurrentStrokes),
+			GuessedPlayers: copyFlags(data.GuessedPlayers),
+		}
+	case *CollaborativeArtData:
+		// Ortak modda tema herkese açıktır, sadece geçmiş gönderilmez
+		gameSnapshot.ModeData = &CollaborativeArtData{
+			CurrentWord:    data.CurrentWord,
+			CurrentStrokes: copyStrokes(data.CurrentStrokes),
+			Layout:         data.Layout,
+			Regions:        copyRegions(data.Regions),
+		}
+	default:
+		gameSnapshot.ModeData = modeDataJSON(game)
+	}
+	return gameSnapshot
+}
+
+// LateJoinerSnapshot, oyuna sonradan katılan oyuncuya gönderilecek kopyayı döndürür. Çizim ve
+// Tahmin'de mevcut turun kelimesi ve tur kaydı çıkarılır; geçmiş turlar ve mevcut çizim gönderilir.
+func (game *Game) LateJoinerSnapshot() *Game {
+	game.Mutex.RLock()
+	defer game.Mutex.RUnlock()
+
+	gameSnapshot := game.snapshot()
+	gameSnapshot.Players = copyPlayers(game.Players)
+	switch data := game.ModeData.(type) {
+	case *DrawArtData:
+		history := make(map[int]RoundRecord, len(data.RoundHistory))
+		for round, record := range data.RoundHistory {
+			if round != game.TurnCount {
+				history[round] = copyRoundRecord(record)
+			}
+		}
+		gameSnapshot.ModeData = &DrawArtData{
+			RoundHistory:   history,
+			CurrentStrokes: copyStrokes(data.CurrentStrokes),
+			GuessedPlayers: copyFlags(data.GuessedPlayers),
+			AfkStrikes:     copyCounts(data.AfkStrikes),
+			Benched:        copyFlags(data.Benched),
+		}
+	case *CollaborativeArtData:
+		history := make(map[int]RoundRecord, len(data.RoundHistory))
+		for round, record := range data.RoundHistory {
+			history[round] = copyRoundRecord(record)
+		}
+		gameSnapshot.ModeData = &CollaborativeArtData{
+			CurrentWord:    data.CurrentWord,
+			RoundHistory:   history,
+			CurrentStrokes: copyStrokes(data.CurrentStrokes),
+			Layout:         data.Layout,
+			Regions:        copyRegions(data.Regions),
+		}
+	default:
+		gameSnapshot.ModeData = modeDataJSON(game)
+	}
+	return gameSnapshot
+}
+
+// modeDataJSON, diğer modların verisini kilit altında JSON'a çevirir. Bu modlar gizli alanlarını
+// zaten json:"-" ile saklar; kopya sadece eşzamanlı erişimi önlemek içindir.
+func modeDataJSON(game *Game) json.RawMessage {
+	if game.ModeData == nil {
+		return nil
+	}
+	data, err := json.Marshal(game.ModeData)
+	if err != nil {
+		log.Printf("Failed to marshal mode data for room %s: %v", game.RoomID, err)
+		return nil
+	}
+	return data
+}
+
+func copyPlayers(players []*Player) []*Player {
+	copied := make([]*Player, len(players))
+	for i, p := range players {
+		player := *p
+		copied[i] = &player
+	}
+	return copied
+}
+
+func copyStrokes(strokes []DrawingStroke) []DrawingStroke {
+	copied := make([]DrawingStroke, len(strokes))
+	copy(copied, strokes)
+	return copied
+}
+
+func copyFlags(flags map[uuid.UUID]bool) map[uuid.UUID]bool {
+	copied := make(map[uuid.UUID]bool, len(flags))
+	for id, flag := range flags {
+		copied[id] = flag
+	}
+	return copied
+}
+
+func copyCounts(counts map[uuid.UUID]int) map[uuid.UUID]int {
+	copied := make(map[uuid.UUID]int, len(counts))
+	for id, count := range counts {
+		copied[id] = count
+	}
+	return copied
+}
+
+func copyRoundRecord(record RoundRecord) RoundRecord {
+	record.AllStrokes = copyStrokes(record.AllStrokes)
+	record.Guesses = append([]domain.GuessRecord{}, record.Guesses...)
+	record.Points = copyCounts(record.Points)
+	return record
+}
+
+func copyRegions(regions map[uuid.UUID]*CanvasRegion) map[uuid.UUID]*CanvasRegion {
+	if regions == nil {
+		return nil
+	}
+	copied := make(map[uuid.UUID]*CanvasRegion, len(regions))
+	for id, region := range regions {
+		r := *region
+		if region.Bounds != nil {
+			bounds := *region.Bounds
+			r.Bounds = &bounds
+		}
+		copied[id] = &r
+	}
+	return copied
+}
@@ -0,0 +1,81 @@
+package hub
+
+import (
+	"context"
+	"game-service/domain"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// summarySaveTimeout, oyun sonu özetinin veritabanına yazılması için süre sınırıdır.
+const summarySaveTimeout = 5 * time.Second
+
+// roundSummarizer, tur bazlı özet üretebilen oyun motorlarının uyguladığı isteğe bağlı arayüzdür.
+// Uygulamayan modların özetinde sadece final sıralaması yer alır.
+type roundSummarizer interface {
+	RoundSummaries(game *Game) []domain.RoundSummary
+}
+
+// teamSummarizer, takımlı modların takım sonuçlarını özete eklemek için uyguladığı arayüzdür.
+type teamSummarizer interface {
+	TeamResults(game *Game) []domain.TeamResult
+}
+
+// buildGameSummary, biten oyunun sıralamasını ve tur istatistiklerini hazırlar.
+// game.Mutex'i kendisi alır; GameHub kilidi tutulurken çağrılabilir.
+func (g *GameHub) buildGameSummary(game *Game, engine IGameEngine, completed bool) *domain.GameSummary {
+	game.Mutex.RLock()
+	defer game.Mutex.RUnlock()
+
+	modeID, _ := strconv.Atoi(game.ModeID)
+	summary := &domain.GameSummary{
+		ID:          uuid.New(),
+		RoomID:      game.RoomID,
+		GameModeID:  modeID,
+		ModeName:    game.ModeName,
+		Completed:   completed,
+		TotalRounds: game.TotalRounds,
+		StartedAt:   game.StartedAt,
+		EndedAt:     time.Now(),
+		Rankings:    make([]domain.PlayerRanking, 0, len(game.Players)),
+		Rounds:      []domain.RoundSummary{},
+	}
+	for _, p := range game.Players {
+		summary.Rankings = append(summary.Rankings, domain.PlayerRanking{
+			UserID:   p.UserID,
+			Username: p.Username,
+			Score:    p.Score,
+		})
+	}
+	if rs, ok := engine.(roundSummarizer); ok {
+		if rounds := rs.RoundSummaries(game); rounds != nil {
+			summary.Rounds = rounds
+		}
+	}
+
+	if ts, ok := engine.(teamSummarizer); ok {
+		summary.Teams = ts.TeamResults(game)
+	}
+
+	summary.Finalize()
+	return summary
+}
+
+// publishGameSummary, özeti odaya yayınlar ve arka planda kalıcı olarak kaydeder.
+func (g *GameHub) publishGameSummary(summary *domain.GameSummary) {
+	g.hub.BroadcastMessage(summary.RoomID, &Message{
+		Type:    "game_summary",
+		Content: summary,
+	})
+
+	go func() {
+		ctx, cancel := context.WithTimeout(g.hub.ctx, summarySaveTimeout)
+		defer cancel()
+		if err := g.hub.repo.SaveGameSummary(ctx, *summary); err != nil {
+			log.Printf("Failed to save game summary for room %s: %v", summary.RoomID, err)
+		}
+	}()
+}
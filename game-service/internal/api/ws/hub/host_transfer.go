@@ -0,0 +1,105 @@
+package hub
+
+import (
+	"context"
+	"game-service/domain"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// handleTransferHostCommand, host'un WS üzerinden oda sahipliğini devretmesini işler.
+func (h *Hub) handleTransferHostCommand(client *domain.Client, msg RoomManagerData) {
+	content, ok := msg.Content.(map[string]interface{})
+	if !ok {
+		h.sendErrorToClient(client, "Geçersiz komut içeriği.")
+		return
+	}
+	targetStr, _ := content["user_id"].(string)
+	targetID, err := uuid.Parse(targetStr)
+	if err != nil {
+		h.sendErrorToClient(client, "Geçersiz oyuncu ID'si.")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(h.ctx, 5*time.Second)
+	defer cancel()
+
+	if err := h.repo.TransferHost(ctx, client.RoomID, client.ID, targetID); err != nil {
+		log.Printf("HOST_TRANSFER_FAIL: %s -> %s in room %s: %v", client.ID, targetID, client.RoomID, err)
+		h.sendErrorToClient(client, err.Error())
+		return
+	}
+
+	h.ApplyHostChange(client.RoomID, client.ID, targetID, "transfer")
+}
+
+// ApplyHostChange, host değişikliğini bağlı client'ların rollerine yansıtır ve odaya duyurur.
+// Veritabanı güncellemesi çağıran tarafından önceden yapılmış olmalıdır.
+func (h *Hub) ApplyHostChange(roomID, oldHostID, newHostID uuid.UUID, reason string) {
+	h.mutex.Lock()
+	if oldHost, ok := h.roomsClients[roomID][oldHostID]; ok {
+		oldHost.Role = domain.RolePlayer
+	}
+	if newHost, ok := h.roomsClients[roomID][newHostID]; ok {
+		newHost.Role = domain.RoleHost
+	}
+	h.mutex.Unlock()
+
+	h.BroadcastMessage(roomID, &Message{
+		Type: "host_changed",
+		Content: map[string]interface{}{
+			"room_id":     roomID,
+			"old_host_id": oldHostID,
+			"new_host_id": newHostID,
+			"reason":      reason,
+		},
+	})
+	log.Printf("Host of room %s changed: %s -> %s (%s)", roomID, oldHostID, newHostID, reason)
+}
+
+// migrateHostIfNeeded, grace period'u dolan oyuncu host ise sahipliği bağlı bir oyuncuya devreder.
+// Co-host'lar önceliklidir.
+func (h *Hub) migrateHostIfNeeded(roomID, leftUserID uuid.UUID) {
+	ctx, cancel := context.WithTimeout(h.ctx, 5*time.Second)
+	defer cancel()
+
+	role, err := h.repo.GetRoomRole(ctx, roomID, leftUserID)
+	if err != nil || role != domain.RoleHost {
+		return
+	}
+
+	candidate := h.pickHostCandidate(roomID, leftUserID)
+	if candidate == uuid.Nil {
+		log.Printf("No connected player to migrate host to in room %s", roomID)
+		return
+	}
+
+	if err := h.repo.TransferHost(ctx, roomID, leftUserID, candidate); err != nil {
+		log.Printf("HOST_MIGRATION_FAIL: room %s, %s -> %s: %v", roomID, leftUserID, candidate, err)
+		return
+	}
+
+	h.ApplyHostChange(roomID, leftUserID, candidate, "host_timeout")
+}
+
+// pickHostCandidate, odada bağlı olan üyelerden yeni host adayını seçer.
+func (h *Hub) pickHostCandidate(roomID, excludeID uuid.UUID) uuid.UUID {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	candidate := uuid.Nil
+	for id, c := range h.roomsClients[roomID] {
+		if id == excludeID || !c.IsMember {
+			continue
+		}
+		if c.Role == domain.RoleCoHost {
+			return id
+		}
+		if candidate == uuid.Nil {
+			candidate = id
+		}
+	}
+	return candidate
+}
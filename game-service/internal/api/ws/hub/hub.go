@@ -58,12 +58,27 @@ type Hub struct {
 		RoomID uuid.UUID
 		Msg    RoomManagerData
 	}
-	repo    Repository
-	roomHub *roomHub
-	gameHub *GameHub // GameHub'ı buraya ekledi
+	repo      Repository
+	roomHub   *roomHub
+	lobbyFeed *lobbyFeed // Oda listesi değişikliklerini lobi bağlantılarına iletir
+
+	// room_players.is_online güncellemeleri sırayla yazılsın diye kuyruk
+	presenceUpdates chan presenceUpdate
+
+	// Oyuncu atma oylamaları (oda başına en fazla bir tane) ve başlatan bazlı bekleme süreleri
+	voteKicks         map[uuid.UUID]*VoteKick
+	voteKickCooldowns map[uuid.UUID]map[uuid.UUID]time.Time
+	voteMutex         sync.Mutex
+
+	// Lobi hazır durumları ve otomatik başlatma geri sayımları
+	lobbyReady map[uuid.UUID]map[uuid.UUID]bool
+	autoStarts map[uuid.UUID]*autoStart
+	lobbyTeams map[uuid.UUID]map[uuid.UUID]int // Takım modunda host'un seçtiği takımlar
+	lobbyMutex sync.Mutex
+	gameHub    *GameHub // GameHub'ı buraya ekledi
 }
 
-func NewHub(redisClient *redis.Client) *Hub {
+func NewHub(redisClient *redis.Client, repo Repository) *Hub {
 	hub := &Hub{
 		// Harita yapısını güncelledik
 		roomsClients: make(map[uuid.UUID]map[uuid.UUID]*domain.Client),
@@ -78,12 +93,21 @@ func NewHub(redisClient *redis.Client) *Hub {
 			RoomID uuid.UUID
 			Msg    RoomManagerData
 		}, 100),
-		ctx: context.Background(),
+		ctx:               context.Background(),
+		repo:              repo,
+		voteKicks:         make(map[uuid.UUID]*VoteKick),
+		voteKickCooldowns: make(map[uuid.UUID]map[uuid.UUID]time.Time),
+		lobbyReady:        make(map[uuid.UUID]map[uuid.UUID]bool),
+		autoStarts:        make(map[uuid.UUID]*autoStart),
+		lobbyTeams:        make(map[uuid.UUID]map[uuid.UUID]int),
+		presenceUpdates:   make(chan presenceUpdate, 100),
 		//roomSubscribers: make(map[uuid.UUID]*redis.PubSub),
 
 	}
 	hub.gameHub = NewGameHub(hub)
 	hub.roomHub = NewRoomHub(hub.redisClient, hub)
+	hub.lobbyFeed = newLobbyFeed(hub.redisClient)
+	go hub.runPresenceWorker()
 	// go hub.GameHubListener()
 	return hub
 }
@@ -97,17 +121,15 @@ func NewHub(redisClient *redis.Client) *Hub {
 //			}
 //		}
 //	}
-func (h *Hub) GetRoomSettings(roomID uuid.UUID) *GameSettings {
-	h.gameHub.mutex.RLock()
-	defer h.gameHub.mutex.RUnlock()
-
-	if settings, exists := h.gameHub.roomSettings[roomID]; exists {
-		return settings
-	}
 
-	return nil
+// GetRoomSettings, odanın ayarlarını döner; önbellekte yoksa veritabanından yükler.
+func (h *Hub) GetRoomSettings(roomID uuid.UUID) *GameSettings {
+	return h.gameHub.loadRoomSettings(roomID)
 }
 func (h *Hub) Run(ctx context.Context) {
+	// Lobi akışı aboneliği
+	go h.lobbyFeed.run(ctx)
+
 	// Ana hub döngüsü, olayları dinler.
 
 	// Bu, tüm senkronizasyon ve kayıt/kayıt silme mantığının kalbidir.
@@ -120,9 +142,28 @@ func (h *Hub) Run(ctx context.Context) {
 				// Her client için okuma ve yazma goroutine'lerini başlatırız.
 				go h.readPump(client)
 				go h.writePump(client)
+				if client.IsMember {
+					h.markPresence(client.RoomID, client.ID, true)
+				}
+				if client.IsSpectator {
+					h.broadcastSpectatorCount(client.RoomID)
+				} else if !h.IsGameActive(client.RoomID) {
+					h.refreshLobby(client.RoomID)
+				}
 			case client := <-h.unregister:
-				// `unregisterClient` client'ı haritadan siler.
-				h.unregisterClient(client)
+				// `unregisterClient` client'ı haritadan siler. Yerine yenisi bağlanmış eski
+				// bağlantılar için (false) başka bir şey yapılmaz.
+				if !h.unregisterClient(client) {
+					continue
+				}
+				if client.IsMember {
+					h.markPresence(client.RoomID, client.ID, false)
+				}
+				if client.IsSpectator {
+					h.broadcastSpectatorCount(client.RoomID)
+				} else {
+					h.handleLobbyLeave(client.RoomID, client.ID)
+				}
 			case incoming := <-h.inboundMessages:
 				// Gelen mesajları işleme (örneğin, GameHub'a iletme)
 				h.gameHub.HandleGameMessage(incoming.RoomID, incoming.Msg)
@@ -202,41 +243,45 @@ func (h *Hub) registerClient(client *domain.Client) {
 }
 
 // unregisterClient handles client unregistration (internal).
-func (h *Hub) unregisterClient(client *domain.Client) {
+// Client gerçekten haritadan silindiyse true döner.
+func (h *Hub) unregisterClient(client *domain.Client) bool {
 	h.mutex.Lock()
 	defer h.mutex.Unlock()
 
 	if client.RoomID == uuid.Nil {
 		log.Printf("Client %s has no room association", client.ID)
-		return
+		return false
 	}
 
 	// İlgili odadan client'ı kaldır
 	roomClients, ok := h.roomsClients[client.RoomID]
 	if !ok {
 		log.Printf("Room %s not found in roomsClients", client.RoomID)
-		return
+		return false
 	}
 
-	// Client'ı roomClients'tan sil
-	if _, exists := roomClients[client.ID]; !exists {
+	// Client'ı roomClients'tan sil. Aynı kullanıcının yeni bağlantısı varsa (yeniden bağlanma)
+	// eski bağlantının kaydı yenisini silmemeli.
+	if existing, exists := roomClients[client.ID]; !exists || existing != client {
 		log.Printf("Client %s not found in room %s", client.ID, client.RoomID)
-		return
+		return false
 	}
 
 	delete(roomClients, client.ID)
 	log.Printf("Client %s unregistered from room %s. Remaining: %d",
 		client.ID, client.RoomID, len(roomClients))
 
-	// 💡 PlayerQuit sinyalini NON-BLOCKING şekilde gönder
-	select {
-	case h.playerQuit <- struct {
-		RoomID uuid.UUID
-		UserID uuid.UUID
-	}{RoomID: client.RoomID, UserID: client.ID}:
-		log.Printf("PlayerQuit signal sent for user %s in room %s", client.ID, client.RoomID)
-	default:
-		log.Printf("WARNING: PlayerQuit channel full, signal dropped for user %s", client.ID)
+	// 💡 PlayerQuit sinyalini NON-BLOCKING şekilde gönder (seyirciler oyunda olmadığı için atlanır)
+	if !client.IsSpectator {
+		select {
+		case h.playerQuit <- struct {
+			RoomID uuid.UUID
+			UserID uuid.UUID
+		}{RoomID: client.RoomID, UserID: client.ID}:
+			log.Printf("PlayerQuit signal sent for user %s in room %s", client.ID, client.RoomID)
+		default:
+			log.Printf("WARNING: PlayerQuit channel full, signal dropped for user %s", client.ID)
+		}
 	}
 
 	// Oda boşaldıysa temizle
@@ -248,6 +293,7 @@ func (h *Hub) unregisterClient(client *domain.Client) {
 
 	// Send kanalını güvenli şekilde kapat
 	h.closeSendChannel(client)
+	return true
 }
 func (h *Hub) closeSendChannel(client *domain.Client) {
 	defer func() {
@@ -315,6 +361,12 @@ func (h *Hub) readPump(client *domain.Client) {
 			continue
 		}
 
+		// Seyirciler sadece ayarları okuyabilir ve seyirci sohbetine yazabilir.
+		if h.isSpectator(client) && msg.Type != "get_room_setting" && msg.Type != "spectator_chat" && msg.Type != "get_lobby_state" && msg.Type != "get_standings" {
+			h.sendErrorToClient(client, "Seyirciler çizim yapamaz veya tahmin gönderemez.")
+			continue
+		}
+
 		switch msg.Type {
 		case "get_room_setting":
 			// Odanın ayarlarını al
@@ -337,7 +389,23 @@ func (h *Hub) readPump(client *domain.Client) {
 				log.Printf("Failed to send room settings to client %s: %v", client.ID, err)
 			}
 
+		case "spectator_chat":
+			h.handleSpectatorChat(client, msg)
+
+		case "player_ready":
+			h.handlePlayerReady(client, msg)
+
+		case "get_lobby_state":
+			h.sendLobbyState(client)
+
 		case "game_started":
+			if !h.requirePermission(client, domain.PermStartGame) {
+				continue
+			}
+			// Rövanş işareti sadece sunucu tarafından eklenebilir
+			if contentMap, ok := msg.Content.(map[string]interface{}); ok {
+				delete(contentMap, rematchFlag)
+			}
 
 			h.inboundMessages <- struct {
 				RoomID uuid.UUID
@@ -347,6 +415,21 @@ func (h *Hub) readPump(client *domain.Client) {
 				Msg:    msg,
 			}
 
+		case "rematch_vote":
+			h.handleRematchVote(client, msg)
+
+		case "rematch_start":
+			if !h.requirePermission(client, domain.PermStartGame) {
+				continue
+			}
+			h.handleRematchStart(client, msg)
+
+		case "get_standings":
+			h.sendStandings(client)
+
+		case "submit_words":
+			h.handleSubmitWords(client, msg)
+
 		case "player_move":
 			// 💡 PlayerID'yi ekleyin
 			if contentMap, ok := msg.Content.(map[string]interface{}); ok {
@@ -362,11 +445,62 @@ func (h *Hub) readPump(client *domain.Client) {
 			}
 
 		case "game_settings_update":
+			if !h.requirePermission(client, domain.PermChangeSettings) {
+				continue
+			}
 			// 💡 PlayerID'yi ekleyin
 			if contentMap, ok := msg.Content.(map[string]interface{}); ok {
 				contentMap["player_id"] = client.ID.String()
 			}
 
+			h.inboundMessages <- struct {
+				RoomID uuid.UUID
+				Msg    RoomManagerData
+			}{
+				RoomID: client.RoomID,
+				Msg:    msg,
+			}
+		case "kick_player", "ban_player":
+			// Sadece host/co-host oyuncu atabilir/banlayabilir
+			perm := domain.PermKickPlayer
+			if msg.Type == "ban_player" {
+				perm = domain.PermBanPlayer
+			}
+			if !h.requirePermission(client, perm) {
+				continue
+			}
+			go h.handleModerationCommand(client, msg)
+
+		case "transfer_host":
+			if !h.requirePermission(client, domain.PermManageRoles) {
+				continue
+			}
+			go h.handleTransferHostCommand(client, msg)
+
+		case "vote_kick_start":
+			go h.handleVoteKickStart(client, msg)
+
+		case "vote_kick_cast":
+			go h.handleVoteKickCast(client, msg)
+
+		case "team_assign":
+			// Takımları sadece ayarları değiştirebilenler seçebilir
+			if !h.requirePermission(client, domain.PermChangeSettings) {
+				continue
+			}
+			go h.handleTeamAssign(client, msg)
+
+		case "game_pause", "game_resume":
+			// Sadece host/co-host oyunu duraklatabilir/devam ettirebilir
+			if !h.requirePermission(client, domain.PermPauseGame) {
+				continue
+			}
+			if contentMap, ok := msg.Content.(map[string]interface{}); ok {
+				contentMap["player_id"] = client.ID.String()
+			} else {
+				msg.Content = map[string]interface{}{"player_id": client.ID.String()}
+			}
+
 			h.inboundMessages <- struct {
 				RoomID uuid.UUID
 				Msg    RoomManagerData
@@ -549,6 +683,141 @@ func (h *Hub) BroadcastToOthers(roomID uuid.UUID, senderID uuid.UUID, msg *Messa
 	}
 }
 
+// BroadcastToSpectators, mesajı sadece odadaki seyircilere gönderir.
+func (h *Hub) BroadcastToSpectators(roomID uuid.UUID, msg *Message) {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	roomClients, ok := h.roomsClients[roomID]
+	if !ok {
+		return
+	}
+
+	messageBytes, err := json.Marshal(msg)
+	if err != nil {
+		log.Printf("Failed to marshal spectator message: %v", err)
+		return
+	}
+
+	for _, client := range roomClients {
+		if !client.IsSpectator {
+			continue
+		}
+		select {
+		case client.Send <- messageBytes:
+		default:
+			log.Printf("Spectator %s's send channel is full, dropping message.", client.ID)
+		}
+	}
+}
+
+// GetSpectatorCount, odadaki bağlı seyirci sayısını döndürür.
+func (h *Hub) GetSpectatorCount(roomID uuid.UUID) int {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	count := 0
+	for _, client := range h.roomsClients[roomID] {
+		if client.IsSpectator {
+			count++
+		}
+	}
+	return count
+}
+
+// GetRoomPlayerCount, seyirciler hariç odadaki bağlı oyuncu sayısını döndürür.
+func (h *Hub) GetRoomPlayerCount(roomID uuid.UUID) int {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	count := 0
+	for _, client := range h.roomsClients[roomID] {
+		if !client.IsSpectator {
+			count++
+		}
+	}
+	return count
+}
+
+func (h *Hub) isSpectator(client *domain.Client) bool {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	return client.IsSpectator
+}
+
+func (h *Hub) broadcastSpectatorCount(roomID uuid.UUID) {
+	h.BroadcastMessage(roomID, &Message{
+		Type: "spectator_count",
+		Content: map[string]interface{}{
+			"room_id": roomID,
+			"count":   h.GetSpectatorCount(roomID),
+		},
+	})
+}
+
+// handleSpectatorChat, seyirci sohbet mesajını sadece diğer seyircilere iletir.
+func (h *Hub) handleSpectatorChat(client *domain.Client, msg RoomManagerData) {
+	if !h.isSpectator(client) {
+		h.sendErrorToClient(client, "Seyirci sohbeti sadece seyirciler içindir.")
+		return
+	}
+
+	settings := h.GetRoomSettings(client.RoomID)
+	if settings != nil && !settings.SpectatorChat {
+		h.sendErrorToClient(client, "Bu odada seyirci sohbeti kapalı.")
+		return
+	}
+
+	contentMap, ok := msg.Content.(map[string]interface{})
+	if !ok {
+		return
+	}
+	text, ok := contentMap["text"].(string)
+	if !ok || text == "" {
+		return
+	}
+
+	h.BroadcastToSpectators(client.RoomID, &Message{
+		Type: "spectator_chat",
+		Content: map[string]interface{}{
+			"user_id": client.ID,
+			"text":    text,
+		},
+	})
+}
+
+// promoteMemberSpectators, oyun bittiğinde odanın üyesi olan seyircileri oyuncuya dönüştürür.
+// Üye olmayan (herkese açık odayı izleyen) seyirciler seyirci olarak kalır.
+func (h *Hub) promoteMemberSpectators(roomID uuid.UUID) {
+	h.mutex.Lock()
+	var promoted []*domain.Client
+	for _, client := range h.roomsClients[roomID] {
+		if client.IsSpectator && client.IsMember {
+			client.IsSpectator = false
+			promoted = append(promoted, client)
+		}
+	}
+	h.mutex.Unlock()
+
+	// Oda tekrar lobiye döndü; hazır durumunu herkese bildir
+	go h.refreshLobby(roomID)
+
+	if len(promoted) == 0 {
+		return
+	}
+
+	for _, client := range promoted {
+		h.SendMessageToClient(client, &Message{
+			Type: "spectator_promoted",
+			Content: map[string]interface{}{
+				"room_id": roomID,
+				"message": "Oyun bitti, artık bir sonraki oyunda oyuncu olarak yer alabilirsin.",
+			},
+		})
+	}
+	h.broadcastSpectatorCount(roomID)
+}
+
 func (h *Hub) GetRoomClientCount(roomID uuid.UUID) int {
 
 	h.mutex.RLock()
@@ -599,6 +868,11 @@ func (h *Hub) GetActiveGame(roomID uuid.UUID) *Game {
 	return h.gameHub.GetActiveGame(roomID)
 }
 
+// AddPlayerToActiveGame, geç katılım açıksa kullanıcıyı devam eden oyuna ekler.
+func (h *Hub) AddPlayerToActiveGame(roomID, userID uuid.UUID) (*Game, error) {
+	return h.gameHub.AddLateJoiner(roomID, userID)
+}
+
 func (h *Hub) IsPlayerInActiveGame(roomID, userID uuid.UUID) bool {
 	h.gameHub.mutex.RLock()
 	defer h.gameHub.mutex.RUnlock()
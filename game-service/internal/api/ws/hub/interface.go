@@ -1,4 +1,29 @@
 package hub
 
+import (
+	"context"
+	"game-service/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
 type Repository interface {
+	KickPlayer(ctx context.Context, roomID, hostID, targetID uuid.UUID) error
+	BanPlayer(ctx context.Context, roomID, hostID, targetID uuid.UUID) error
+	LeaveRoom(ctx context.Context, roomID, userID uuid.UUID) (uuid.UUID, error)
+	TransferHost(ctx context.Context, roomID, hostID, targetID uuid.UUID) error
+	GetRoomRole(ctx context.Context, roomID, userID uuid.UUID) (domain.RoomRole, error)
+	UpdateRoomStatus(ctx context.Context, roomID uuid.UUID, status string) (bool, error)
+	GetRoomSummary(ctx context.Context, roomID uuid.UUID) (domain.Room, error)
+	SetPlayerOnline(ctx context.Context, roomID, userID uuid.UUID, online bool) error
+	GetStaleMembers(ctx context.Context, offlineFor time.Duration) ([]domain.RoomMember, error)
+	ReconcileRoomPlayerCounts(ctx context.Context) ([]uuid.UUID, error)
+	DeleteEmptyRooms(ctx context.Context) ([]uuid.UUID, error)
+	ResetRuntimeState(ctx context.Context, liveMembers []domain.RoomMember) error
+	GetRoomSettings(ctx context.Context, roomID uuid.UUID) (domain.RoomSettings, error)
+	GetGameMode(ctx context.Context, gameModeID int) (domain.GameMode, error)
+	UpdateRoomGameMode(ctx context.Context, roomID uuid.UUID, userID uuid.UUID, newGameModeID int) (domain.GameMode, error)
+	UpdateRoomSettings(ctx context.Context, roomID, actorID uuid.UUID, patch domain.RoomSettingsPatch) (domain.RoomSettings, error)
+	SaveGameSummary(ctx context.Context, summary domain.GameSummary) error
 }
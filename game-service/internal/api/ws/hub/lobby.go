@@ -0,0 +1,237 @@
+package hub
+
+import (
+	"game-service/domain"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// lobbyPlayer, lobi durumunda her oyuncu için gönderilen bilgidir.
+type lobbyPlayer struct {
+	UserID uuid.UUID       `json:"user_id"`
+	Role   domain.RoomRole `json:"role"`
+	Ready  bool            `json:"ready"`
+	Team   int             `json:"team,omitempty"` // Takım modunda host'un seçtiği takım
+}
+
+// autoStart, bir odada devam eden otomatik başlatma geri sayımını tutar.
+type autoStart struct {
+	timer    *time.Timer
+	StartsAt time.Time
+}
+
+// handlePlayerReady, oyuncunun hazır durumunu değiştirir.
+func (h *Hub) handlePlayerReady(client *domain.Client, msg RoomManagerData) {
+	roomID := client.RoomID
+	if h.IsGameActive(roomID) {
+		h.sendErrorToClient(client, "Oyun devam ederken hazır durumu değiştirilemez.")
+		return
+	}
+
+	ready := true
+	if content, ok := msg.Content.(map[string]interface{}); ok {
+		if value, ok := content["ready"].(bool); ok {
+			ready = value
+		}
+	}
+
+	h.lobbyMutex.Lock()
+	if h.lobbyReady[roomID] == nil {
+		h.lobbyReady[roomID] = make(map[uuid.UUID]bool)
+	}
+	if ready {
+		h.lobbyReady[roomID][client.ID] = true
+	} else {
+		delete(h.lobbyReady[roomID], client.ID)
+	}
+	h.lobbyMutex.Unlock()
+
+	log.Printf("Player %s ready=%v in room %s", client.ID, ready, roomID)
+	h.refreshLobby(roomID)
+}
+
+// handleLobbyLeave, lobiden ayrılan oyuncunun hazır durumunu siler ve lobiyi günceller.
+func (h *Hub) handleLobbyLeave(roomID, userID uuid.UUID) {
+	h.lobbyMutex.Lock()
+	delete(h.lobbyReady[roomID], userID)
+	h.lobbyMutex.Unlock()
+
+	if h.IsGameActive(roomID) {
+		return
+	}
+	h.refreshLobby(roomID)
+}
+
+// refreshLobby, lobi durumunu yayınlar ve otomatik başlatma geri sayımını başlatır/iptal eder.
+func (h *Hub) refreshLobby(roomID uuid.UUID) {
+	players, readyCount := h.lobbySnapshot(roomID)
+
+	minPlayers, countdown := 2, 0
+	if settings := h.GetRoomSettings(roomID); settings != nil {
+		minPlayers = settings.MinPlayers
+		countdown = settings.AutoStartCountdown
+	}
+	allReady := len(players) >= minPlayers && readyCount == len(players)
+
+	if allReady && countdown > 0 {
+		h.startAutoStart(roomID, countdown)
+	} else {
+		h.cancelAutoStart(roomID)
+	}
+
+	h.broadcastLobbyState(roomID, players, readyCount, minPlayers, allReady)
+}
+
+// lobbySnapshot, bağlı oyuncuları (izleyiciler hariç) ve hazır olanların sayısını döner.
+func (h *Hub) lobbySnapshot(roomID uuid.UUID) ([]lobbyPlayer, int) {
+	h.mutex.RLock()
+	players := make([]lobbyPlayer, 0, len(h.roomsClients[roomID]))
+	for id, c := range h.roomsClients[roomID] {
+		if c.IsSpectator {
+			continue
+		}
+		players = append(players, lobbyPlayer{UserID: id, Role: c.Role})
+	}
+	h.mutex.RUnlock()
+
+	h.lobbyMutex.Lock()
+	defer h.lobbyMutex.Unlock()
+
+	readyCount := 0
+	for i := range players {
+		if h.lobbyReady[roomID][players[i].UserID] {
+			players[i].Ready = true
+			readyCount++
+		}
+		players[i].Team = h.lobbyTeams[roomID][players[i].UserID]
+	}
+	return players, readyCount
+}
+
+func (h *Hub) broadcastLobbyState(roomID uuid.UUID, players []lobbyPlayer, readyCount, minPlayers int, allReady bool) {
+	content := map[string]interface{}{
+		"room_id":      roomID,
+		"players":      players,
+		"ready_count":  readyCount,
+		"player_count": len(players),
+		"min_players":  minPlayers,
+		"all_ready":    allReady,
+	}
+
+	h.lobbyMutex.Lock()
+	if pending, ok := h.autoStarts[roomID]; ok {
+		content["auto_start_at"] = pending.StartsAt
+	}
+	h.lobbyMutex.Unlock()
+
+	h.BroadcastMessage(roomID, &Message{
+		Type:    "lobby_state",
+		Content: content,
+	})
+}
+
+// sendLobbyState, lobi durumunu sadece isteyen client'a gönderir.
+func (h *Hub) sendLobbyState(client *domain.Client) {
+	players, readyCount := h.lobbySnapshot(client.RoomID)
+	minPlayers := 2
+	if settings := h.GetRoomSettings(client.RoomID); settings != nil {
+		minPlayers = settings.MinPlayers
+	}
+
+	if err := h.SendMessageToClient(client, &Message{
+		Type: "lobby_state",
+		Content: map[string]interface{}{
+			"room_id":      client.RoomID,
+			"players":      players,
+			"ready_count":  readyCount,
+			"player_count": len(players),
+			"min_players":  minPlayers,
+			"all_ready":    len(players) >= minPlayers && readyCount == len(players),
+		},
+	}); err != nil {
+		log.Printf("Failed to send lobby state to client %s: %v", client.ID, err)
+	}
+}
+
+// ReadyPlayerCount, odada bağlı ve hazır olan oyuncu sayısını döner.
+func (h *Hub) ReadyPlayerCount(roomID uuid.UUID) int {
+	_, readyCount := h.lobbySnapshot(roomID)
+	return readyCount
+}
+
+// startAutoStart, geri sayım başlatır. Süre dolunca oyun, host komutuyla aynı yoldan başlatılır.
+func (h *Hub) startAutoStart(roomID uuid.UUID, seconds int) {
+	h.lobbyMutex.Lock()
+	if _, exists := h.autoStarts[roomID]; exists {
+		h.lobbyMutex.Unlock()
+		return
+	}
+	pending := &autoStart{StartsAt: time.Now().Add(time.Duration(seconds) * time.Second)}
+	pending.timer = time.AfterFunc(time.Duration(seconds)*time.Second, func() {
+		h.lobbyMutex.Lock()
+		if h.autoStarts[roomID] != pending {
+			h.lobbyMutex.Unlock()
+			return
+		}
+		delete(h.autoStarts, roomID)
+		h.lobbyMutex.Unlock()
+
+		log.Printf("Auto-start countdown finished for room %s", roomID)
+		h.inboundMessages <- struct {
+			RoomID uuid.UUID
+			Msg    RoomManagerData
+		}{
+			RoomID: roomID,
+			Msg: RoomManagerData{
+				Type:    "game_started",
+				Content: map[string]interface{}{"auto_start": true},
+			},
+		}
+	})
+	h.autoStarts[roomID] = pending
+	h.lobbyMutex.Unlock()
+
+	h.BroadcastMessage(roomID, &Message{
+		Type: "auto_start_countdown",
+		Content: map[string]interface{}{
+			"room_id":   roomID,
+			"seconds":   seconds,
+			"starts_at": pending.StartsAt,
+		},
+	})
+}
+
+// cancelAutoStart, devam eden geri sayımı iptal eder ve odaya bildirir.
+func (h *Hub) cancelAutoStart(roomID uuid.UUID) {
+	h.lobbyMutex.Lock()
+	pending, exists := h.autoStarts[roomID]
+	if exists {
+		pending.timer.Stop()
+		delete(h.autoStarts, roomID)
+	}
+	h.lobbyMutex.Unlock()
+
+	if !exists {
+		return
+	}
+	h.BroadcastMessage(roomID, &Message{
+		Type: "auto_start_cancelled",
+		Content: map[string]interface{}{
+			"room_id": roomID,
+		},
+	})
+}
+
+// resetLobby, oyun başladığında hazır durumlarını ve geri sayımı temizler;
+// bir sonraki oyun için oyuncuların tekrar hazır olması gerekir.
+func (h *Hub) resetLobby(roomID uuid.UUID) {
+	h.lobbyMutex.Lock()
+	delete(h.lobbyReady, roomID)
+	if pending, ok := h.autoStarts[roomID]; ok {
+		pending.timer.Stop()
+		delete(h.autoStarts, roomID)
+	}
+	h.lobbyMutex.Unlock()
+}
@@ -0,0 +1,211 @@
+package hub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"game-service/domain"
+	roomredis "game-service/infra/redis"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/fasthttp/websocket"
+	contribws "github.com/gofiber/contrib/websocket"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const lobbyClientSendSize = 32
+
+// lobbyEvent, Redis lobi kanalından gelen mesajdır (infra/redis PubSubMessage).
+type lobbyEvent struct {
+	Type      string          `json:"type"`
+	RoomID    string          `json:"roomId"`
+	Data      json.RawMessage `json:"data"`
+	Timestamp time.Time       `json:"timestamp"`
+}
+
+// lobbyClient, lobi akışını dinleyen tek bir bağlantıdır; odaya bağlı değildir.
+type lobbyClient struct {
+	userID uuid.UUID
+	conn   *contribws.Conn
+	send   chan []byte
+	once   sync.Once
+}
+
+func (c *lobbyClient) close() {
+	c.once.Do(func() { close(c.send) })
+}
+
+// lobbyFeed, oda listesi değişikliklerini lobi bağlantılarına dağıtır.
+type lobbyFeed struct {
+	redisClient *redis.Client
+	clients     map[*lobbyClient]struct{}
+	mutex       sync.RWMutex
+}
+
+func newLobbyFeed(redisClient *redis.Client) *lobbyFeed {
+	return &lobbyFeed{
+		redisClient: redisClient,
+		clients:     make(map[*lobbyClient]struct{}),
+	}
+}
+
+// run, lobi kanalına abone olur ve gelen olayları bağlı tüm lobi client'larına iletir.
+func (lf *lobbyFeed) run(ctx context.Context) {
+	pubsub := lf.redisClient.Subscribe(ctx, roomredis.LobbyChannel)
+	defer pubsub.Close()
+	log.Printf("Subscribed to Redis channel: %s", roomredis.LobbyChannel)
+
+	for msg := range pubsub.Channel() {
+		var event lobbyEvent
+		if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+			log.Printf("Failed to unmarshal lobby event: %v", err)
+			continue
+		}
+
+		payload, err := json.Marshal(&Message{Type: event.Type, Content: event.Data})
+		if err != nil {
+			log.Printf("Failed to marshal lobby message: %v", err)
+			continue
+		}
+		lf.broadcast(payload)
+	}
+	log.Printf("Unsubscribed from Redis channel: %s", roomredis.LobbyChannel)
+}
+
+// broadcast, mesajı tüm lobi client'larına gönderir. Kuyruğu dolan yavaş client'lar düşürülür;
+// yeniden bağlanıp listeyi GET /rooms ile tazelemeleri beklenir.
+func (lf *lobbyFeed) broadcast(payload []byte) {
+	lf.mutex.Lock()
+	defer lf.mutex.Unlock()
+
+	for client := range lf.clients {
+		select {
+		case client.send <- payload:
+		default:
+			log.Printf("Lobby client %s is too slow, dropping connection", client.userID)
+			delete(lf.clients, client)
+			client.close()
+		}
+	}
+}
+
+func (lf *lobbyFeed) add(client *lobbyClient) {
+	lf.mutex.Lock()
+	lf.clients[client] = struct{}{}
+	lf.mutex.Unlock()
+}
+
+func (lf *lobbyFeed) remove(client *lobbyClient) {
+	lf.mutex.Lock()
+	if _, ok := lf.clients[client]; ok {
+		delete(lf.clients, client)
+		client.close()
+	}
+	lf.mutex.Unlock()
+}
+
+// publishLobbyRoom, odanın güncel halini lobi kanalına room_updated olarak yayınlar; oda artık yoksa
+// room_deleted gönderilir. Gizli odalar lobi listesinde herkese görünmediğinden yayınlanmaz.
+func (h *Hub) publishLobbyRoom(ctx context.Context, roomID uuid.UUID) {
+	room, err := h.repo.GetRoomSummary(ctx, roomID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			h.publishLobbyEvent(ctx, roomredis.MSG_ROOM_DELETED, roomID, map[string]string{"room_id": roomID.String()})
+			return
+		}
+		log.Printf("Failed to load room %s for lobby feed: %v", roomID, err)
+		return
+	}
+	if room.IsPrivate {
+		return
+	}
+	h.publishLobbyEvent(ctx, roomredis.MSG_ROOM_UPDATED, roomID, room)
+}
+
+// publishLobbyEvent, lobi kanalına HTTP usecase'leriyle aynı formatta olay yayınlar.
+func (h *Hub) publishLobbyEvent(ctx context.Context, msgType string, roomID uuid.UUID, content interface{}) {
+	data, err := json.Marshal(content)
+	if err != nil {
+		log.Printf("Failed to marshal lobby content for room %s: %v", roomID, err)
+		return
+	}
+	payload, err := json.Marshal(&lobbyEvent{
+		Type:      msgType,
+		RoomID:    roomID.String(),
+		Data:      data,
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		log.Printf("Failed to marshal lobby event: %v", err)
+		return
+	}
+	if err := h.redisClient.Publish(ctx, roomredis.LobbyChannel, payload).Err(); err != nil {
+		log.Printf("Failed to publish lobby event %s for room %s: %v", msgType, roomID, err)
+	}
+}
+
+// ServeLobby, lobi WebSocket bağlantısını akışa ekler ve bağlantı kapanana kadar bloklar.
+// Lobi bağlantısı sadece dinler; client'tan gelen mesajlar yok sayılır.
+func (h *Hub) ServeLobby(conn *contribws.Conn, userID uuid.UUID) {
+	client := &lobbyClient{
+		userID: userID,
+		conn:   conn,
+		send:   make(chan []byte, lobbyClientSendSize),
+	}
+	h.lobbyFeed.add(client)
+	log.Printf("Lobby client %s connected", userID)
+
+	go h.lobbyWritePump(client)
+
+	conn.SetReadLimit(maxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		conn.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				log.Printf("Lobby client %s read error: %v", userID, err)
+			}
+			break
+		}
+	}
+
+	h.lobbyFeed.remove(client)
+	conn.Close()
+	log.Printf("Lobby client %s disconnected", userID)
+}
+
+// lobbyWritePump, lobi client'ının kuyruğundaki mesajları yazar ve bağlantıyı ping ile canlı tutar.
+func (h *Hub) lobbyWritePump(client *lobbyClient) {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		client.conn.Close()
+	}()
+
+	for {
+		select {
+		case msg, ok := <-client.send:
+			client.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				client.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+			if err := client.conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+				log.Printf("Lobby client %s write error: %v", client.userID, err)
+				return
+			}
+
+		case <-ticker.C:
+			client.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := client.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package hub
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// handleModerationCommand, host'un WS üzerinden gönderdiği kick_player/ban_player
+// komutunu veritabanına işler ve oyuncuyu odadan çıkarır.
+func (h *Hub) handleModerationCommand(client *domain.Client, msg RoomManagerData) {
+	content, ok := msg.Content.(map[string]interface{})
+	if !ok {
+		h.sendErrorToClient(client, "Geçersiz komut içeriği.")
+		return
+	}
+	targetStr, _ := content["user_id"].(string)
+	targetID, err := uuid.Parse(targetStr)
+	if err != nil {
+		h.sendErrorToClient(client, "Geçersiz oyuncu ID'si.")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(h.ctx, 5*time.Second)
+	defer cancel()
+
+	banned := msg.Type == "ban_player"
+	if banned {
+		err = h.repo.BanPlayer(ctx, client.RoomID, client.ID, targetID)
+	} else {
+		err = h.repo.KickPlayer(ctx, client.RoomID, client.ID, targetID)
+	}
+	if err != nil {
+		log.Printf("MODERATION_FAIL: %s by %s on %s in room %s: %v", msg.Type, client.ID, targetID, client.RoomID, err)
+		h.sendErrorToClient(client, err.Error())
+		return
+	}
+
+	h.RemoveModeratedPlayer(client.RoomID, targetID, client.ID, banned)
+}
+
+// RemoveModeratedPlayer, atılan/banlanan oyuncuyu odadan çıkarır.
+// Veritabanı işlemi çağıran tarafından önceden yapılmış olmalıdır.
+func (h *Hub) RemoveModeratedPlayer(roomID, userID, byID uuid.UUID, banned bool) {
+	msgType := "player_kicked"
+	text := "Oda sahibi tarafından odadan atıldınız."
+	if banned {
+		msgType = "player_banned"
+		text = "Oda sahibi tarafından bu odadan banlandınız."
+	}
+
+	h.evictPlayer(roomID, userID, msgType, text, map[string]interface{}{"by": byID})
+	log.Printf("User %s removed from room %s (%s) by %s", userID, roomID, msgType, byID)
+}
+
+// evictPlayer, oyuncuyu bilgilendirir, soketini kapatır, aktif oyunun çizer
+// sırasından çıkarır ve odaya msgType ile duyurur.
+func (h *Hub) evictPlayer(roomID, userID uuid.UUID, msgType, text string, extra map[string]interface{}) {
+	// 1. Oyuncuya doğrudan bildir ve bağlantısını kapat
+	h.mutex.RLock()
+	target := h.roomsClients[roomID][userID]
+	h.mutex.RUnlock()
+
+	if target != nil {
+		target.WriteLock.Lock()
+		target.Conn.SetWriteDeadline(time.Now().Add(writeWait))
+		if err := target.Conn.WriteJSON(&Message{
+			Type: msgType,
+			Content: map[string]interface{}{
+				"room_id": roomID,
+				"message": text,
+			},
+		}); err != nil {
+			log.Printf("Failed to notify evicted user %s: %v", userID, err)
+		}
+		target.WriteLock.Unlock()
+		// readPump hata alıp client'ı unregister edecek
+		target.Conn.Close()
+	}
+
+	// 2. Aktif oyundan çıkar
+	h.gameHub.RemovePlayerFromGame(roomID, userID, msgType)
+
+	// 3. Odadaki herkese duyur
+	content := map[string]interface{}{
+		"room_id": roomID,
+		"user_id": userID,
+	}
+	for k, v := range extra {
+		content[k] = v
+	}
+	h.BroadcastMessage(roomID, &Message{
+		Type:    msgType,
+		Content: content,
+	})
+}
+
+// RemovePlayerFromGame, oyuncuyu grace period beklemeden aktif oyundan çıkarır.
+// Çizer çıkarıldıysa tur bitirilir, oyuncu sayısı yetersiz kalırsa oyun sonlandırılır.
+func (g *GameHub) RemovePlayerFromGame(roomID, userID uuid.UUID, reason string) {
+	g.mutex.Lock()
+	game, exists := g.activeGames[roomID]
+	if !exists || game.State != GameStateInProgress {
+		g.mutex.Unlock()
+		return
+	}
+	minPlayers := 2
+	if settings, ok := g.roomSettings[roomID]; ok {
+		minPlayers = settings.MinPlayers
+	}
+
+	game.Mutex.Lock()
+	removed, wasActiveDrawer := game.removePlayer(userID)
+	remaining := len(game.Players)
+	game.Mutex.Unlock()
+	g.mutex.Unlock()
+
+	if removed == nil {
+		return
+	}
+	log.Printf("Player %s removed from game in room %s (%s). Remaining: %d", userID, roomID, reason, remaining)
+
+	if remaining < minPlayers {
+		g.stopRoundTimer(roomID)
+		g.handleEndGame(roomID, RoomManagerData{
+			Type: "end_game",
+			Content: map[string]interface{}{
+				"room_id": roomID,
+				"reason":  "insufficient_players",
+				"message": fmt.Sprintf("Oyun sonlandırıldı. Minimum %d oyuncu gerekli.", minPlayers),
+			},
+		})
+		return
+	}
+
+	if wasActiveDrawer {
+		select {
+		case g.roundEndSignal <- RoundEndSignal{RoomID: roomID, Reason: reason}:
+		default:
+			log.Printf("WARNING: roundEndSignal channel full, calling handleRoundEnd directly")
+			go g.handleRoundEnd(roomID, reason)
+		}
+	}
+}
+
+// removePlayer, oyuncuyu listeden çıkarır ve çizer sırasının kaymaması için
+// CurrentDrawerIndex'i düzeltir. Çağıran game.Mutex kilidini tutmalıdır.
+func (game *Game) removePlayer(userID uuid.UUID) (*Player, bool) {
+	idx := -1
+	for i, p := range game.Players {
+		if p.UserID == userID {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return nil, false
+	}
+
+	removed := game.Players[idx]
+	wasActiveDrawer := game.ActivePlayer == userID
+	game.Players = append(game.Players[:idx:idx], game.Players[idx+1:]...)
+
+	switch {
+	case idx < game.CurrentDrawerIndex:
+		game.CurrentDrawerIndex--
+	case idx == game.CurrentDrawerIndex:
+		// Bir sonraki getNextDrawer çağrısı, çıkarılanın yerine geçen oyuncuyu seçsin
+		game.CurrentDrawerIndex = idx - 1
+		if game.CurrentDrawerIndex < 0 {
+			game.CurrentDrawerIndex = len(game.Players) - 1
+		}
+	}
+
+	return removed, wasActiveDrawer
+}
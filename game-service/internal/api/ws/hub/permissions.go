@@ -0,0 +1,57 @@
+package hub
+
+import (
+	"game-service/domain"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// clientRole, client'ın güncel rolünü döner (rol değişiklikleri Redis'ten gelebilir).
+func (h *Hub) clientRole(client *domain.Client) domain.RoomRole {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	return client.Role
+}
+
+// requirePermission, client'ın işlemi yapmaya yetkisi yoksa yapılandırılmış
+// "permission_denied" mesajı gönderir ve false döner.
+func (h *Hub) requirePermission(client *domain.Client, perm domain.Permission) bool {
+	role := h.clientRole(client)
+	if role.Can(perm) {
+		return true
+	}
+
+	permErr := domain.NewPermissionError(role, perm)
+	if err := h.SendMessageToClient(client, &Message{
+		Type: "permission_denied",
+		Content: map[string]interface{}{
+			"action":  permErr.Action,
+			"role":    permErr.Role,
+			"message": "Bu işlem için yetkiniz yok.",
+		},
+	}); err != nil {
+		log.Printf("Failed to send permission error to client %s: %v", client.ID, err)
+	}
+	return false
+}
+
+// SetClientRole, bağlı client'ın rolünü günceller ve odaya duyurur.
+func (h *Hub) SetClientRole(roomID, userID uuid.UUID, role domain.RoomRole, byID uuid.UUID) {
+	h.mutex.Lock()
+	if client, ok := h.roomsClients[roomID][userID]; ok {
+		client.Role = role
+	}
+	h.mutex.Unlock()
+
+	h.BroadcastMessage(roomID, &Message{
+		Type: "role_changed",
+		Content: map[string]interface{}{
+			"room_id": roomID,
+			"user_id": userID,
+			"role":    role,
+			"by":      byID,
+		},
+	})
+	log.Printf("User %s role changed to %s in room %s", userID, role, roomID)
+}
@@ -0,0 +1,133 @@
+package hub
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Birden fazla game-service instance'ı çalışabildiği için bağlı üyeler Redis'te de tutulur.
+// Anahtarlar her temizlik turunda tazelenir; instance kapanırsa TTL sonunda kendiliğinden silinir.
+const (
+	presenceKeyPrefix = "presence:"
+	presenceTTL       = 3 * cleanupInterval
+)
+
+type presenceUpdate struct {
+	RoomID uuid.UUID
+	UserID uuid.UUID
+	Online bool
+}
+
+// markPresence, üyenin çevrimiçi durumunu sıraya ekler. Güncellemeler tek bir worker tarafından
+// sırayla yazılır; hızlı yeniden bağlanmalarda "çevrimdışı" kaydı "çevrimiçi"nin üzerine yazılmaz.
+// Run döngüsünü bloklamamak için kuyruk doluysa güncelleme atlanır; temizlik işi sapmayı düzeltir.
+func (h *Hub) markPresence(roomID, userID uuid.UUID, online bool) {
+	select {
+	case h.presenceUpdates <- presenceUpdate{RoomID: roomID, UserID: userID, Online: online}:
+	default:
+		log.Printf("WARNING: Presence queue full, update dropped for user %s in room %s", userID, roomID)
+	}
+}
+
+// runPresenceWorker, room_players.is_online ve last_seen_at alanlarını günceller.
+func (h *Hub) runPresenceWorker() {
+	for update := range h.presenceUpdates {
+		ctx, cancel := context.WithTimeout(h.ctx, 5*time.Second)
+		if err := h.repo.SetPlayerOnline(ctx, update.RoomID, update.UserID, update.Online); err != nil {
+			log.Printf("PRESENCE_FAIL: user %s in room %s (online=%v): %v", update.UserID, update.RoomID, update.Online, err)
+		}
+		h.setSharedPresence(ctx, update)
+		cancel()
+	}
+}
+
+// isClientConnected, kullanıcının odaya bu hub üzerinden bağlı olup olmadığını döner.
+func (h *Hub) isClientConnected(roomID, userID uuid.UUID) bool {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	_, ok := h.roomsClients[roomID][userID]
+	return ok
+}
+
+func presenceKey(roomID, userID uuid.UUID) string {
+	return fmt.Sprintf("%s%s:%s", presenceKeyPrefix, roomID, userID)
+}
+
+// setSharedPresence, üyenin bu instance'a bağlı olduğunu Redis'e yazar ya da kaydı siler.
+// Üye başka bir instance'a yeniden bağlandıysa o instance bir sonraki temizlik turunda anahtarı geri yazar.
+func (h *Hub) setSharedPresence(ctx context.Context, update presenceUpdate) {
+	key := presenceKey(update.RoomID, update.UserID)
+	var err error
+	if update.Online {
+		err = h.redisClient.Set(ctx, key, 1, presenceTTL).Err()
+	} else {
+		err = h.redisClient.Del(ctx, key).Err()
+	}
+	if err != nil {
+		log.Printf("PRESENCE_FAIL: shared presence for user %s in room %s (online=%v): %v", update.UserID, update.RoomID, update.Online, err)
+	}
+}
+
+// refreshSharedPresence, bu instance'a bağlı tüm üyelerin Redis anahtarlarını tazeler.
+func (h *Hub) refreshSharedPresence(ctx context.Context) {
+	h.mutex.RLock()
+	var keys []string
+	for roomID, clients := range h.roomsClients {
+		for userID, client := range clients {
+			if client.IsMember {
+				keys = append(keys, presenceKey(roomID, userID))
+			}
+		}
+	}
+	h.mutex.RUnlock()
+	if len(keys) == 0 {
+		return
+	}
+
+	pipe := h.redisClient.Pipeline()
+	for _, key := range keys {
+		pipe.Set(ctx, key, 1, presenceTTL)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("PRESENCE_FAIL: failed to refresh shared presence: %v", err)
+	}
+}
+
+// isConnectedElsewhere, üyenin başka bir instance'a bağlı olup olmadığını Redis'ten kontrol eder.
+// Redis'e ulaşılamazsa üye bağlı sayılır; emin olunmadan kimse odadan çıkarılmaz.
+func (h *Hub) isConnectedElsewhere(ctx context.Context, roomID, userID uuid.UUID) bool {
+	count, err := h.redisClient.Exists(ctx, presenceKey(roomID, userID)).Result()
+	if err != nil {
+		log.Printf("PRESENCE_FAIL: failed to check shared presence for user %s in room %s: %v", userID, roomID, err)
+		return true
+	}
+	return count > 0
+}
+
+// liveMembers, Redis'e göre herhangi bir instance'a bağlı olan üyeleri döner.
+func (h *Hub) liveMembers(ctx context.Context) ([]domain.RoomMember, error) {
+	var members []domain.RoomMember
+	iter := h.redisClient.Scan(ctx, 0, presenceKeyPrefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		ids := strings.Split(strings.TrimPrefix(iter.Val(), presenceKeyPrefix), ":")
+		if len(ids) != 2 {
+			continue
+		}
+		roomID, errRoom := uuid.Parse(ids[0])
+		userID, errUser := uuid.Parse(ids[1])
+		if errRoom != nil || errUser != nil {
+			continue
+		}
+		members = append(members, domain.RoomMember{RoomID: roomID, UserID: userID})
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan presence keys: %w", err)
+	}
+	return members, nil
+}
@@ -0,0 +1,383 @@
+package hub
+
+import (
+	"context"
+	"errors"
+	"game-service/domain"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// rematchFlag, rövanş için kuyruğa eklenen game_started mesajını işaretler. İstemciden gelen
+// game_started mesajlarında bu anahtar silinir.
+const rematchFlag = "rematch"
+
+var errUnsupportedMode = errors.New("Bu oyun modu sunucuda desteklenmiyor.")
+
+// postGame, oyun bittikten sonraki sonuç ekranı ve rövanş oylaması aşamasıdır.
+// Yeni bir oyun başlayana kadar sürer; oyuncular lobide kalır.
+type postGame struct {
+	Players    map[uuid.UUID]bool // Biten oyunun oyuncuları (sadece onlar oy verebilir)
+	Votes      map[uuid.UUID]bool // oy veren -> evet/hayır
+	GameNumber int
+	EndedAt    time.Time
+	Starting   bool // Rövanş başlatma kuyruğa eklendi; aynı anda gelen oylar ikinci kez başlatmasın
+}
+
+// standing, bir oyuncunun oda oturumu boyunca (rövanşlar dahil) biriken sonucudur.
+type standing struct {
+	UserID      uuid.UUID `json:"user_id"`
+	Username    string    `json:"username"`
+	TotalScore  int       `json:"total_score"`
+	Wins        int       `json:"wins"`
+	GamesPlayed int       `json:"games_played"`
+	LastScore   int       `json:"last_score"`
+}
+
+// sessionStandings, bir odada oynanan tüm oyunların toplu sıralamasıdır.
+type sessionStandings struct {
+	GamesPlayed int
+	Players     map[uuid.UUID]*standing
+}
+
+// rematchTally, rövanş oylamasının o anki durumunu bağlı oyunculara göre hesaplar.
+type rematchTally struct {
+	Yes      int `json:"yes"`
+	No       int `json:"no"`
+	Eligible int `json:"eligible"`
+	Required int `json:"required"`
+}
+
+// beginPostGame, biten oyunun skorlarını oturum sıralamasına ekler, rövanş oylamasını açar ve
+// sonuç ekranını yayınlar. completed false ise (oyun erken bitirildiyse) kazanan sayılmaz.
+// GameHub kilidi tutulurken çağrılabilir; game.Mutex'i kendisi alır.
+func (g *GameHub) beginPostGame(roomID uuid.UUID, game *Game, completed bool) {
+	game.Mutex.RLock()
+	players := make([]Player, 0, len(game.Players))
+	for _, p := range game.Players {
+		players = append(players, *p)
+	}
+	game.Mutex.RUnlock()
+
+	// Kazananlar: en yüksek skora sahip oyuncular (skor alınmamışsa kazanan yok)
+	maxScore := 0
+	for _, p := range players {
+		if p.Score > maxScore {
+			maxScore = p.Score
+		}
+	}
+
+	g.rematchMutex.Lock()
+	session, ok := g.standings[roomID]
+	if !ok {
+		session = &sessionStandings{Players: make(map[uuid.UUID]*standing)}
+		g.standings[roomID] = session
+	}
+	session.GamesPlayed++
+
+	results := make([]map[string]interface{}, 0, len(players))
+	participants := make(map[uuid.UUID]bool, len(players))
+	for _, p := range players {
+		s, ok := session.Players[p.UserID]
+		if !ok {
+			s = &standing{UserID: p.UserID}
+			session.Players[p.UserID] = s
+		}
+		won := completed && maxScore > 0 && p.Score == maxScore
+		s.Username = p.Username
+		s.TotalScore += p.Score
+		s.LastScore = p.Score
+		s.GamesPlayed++
+		if won {
+			s.Wins++
+		}
+		participants[p.UserID] = true
+		results = append(results, map[string]interface{}{
+			"user_id":  p.UserID,
+			"username": p.Username,
+			"score":    p.Score,
+			"winner":   won,
+		})
+	}
+
+	g.postGames[roomID] = &postGame{
+		Players:    participants,
+		Votes:      make(map[uuid.UUID]bool),
+		GameNumber: session.GamesPlayed,
+		EndedAt:    time.Now(),
+	}
+	standings := g.standingsLocked(roomID)
+	gameNumber := session.GamesPlayed
+	g.rematchMutex.Unlock()
+
+	g.hub.BroadcastMessage(roomID, &Message{
+		Type: "post_game",
+		Content: map[string]interface{}{
+			"room_id":     roomID,
+			"game_number": gameNumber,
+			"completed":   completed,
+			"results":     results,
+			"standings":   standings,
+			"tally":       g.rematchTally(roomID),
+		},
+	})
+}
+
+// standingsLocked, oturum sıralamasını toplam puana, sonra galibiyete göre sıralı döner.
+// rematchMutex tutulurken çağrılmalıdır.
+func (g *GameHub) standingsLocked(roomID uuid.UUID) []standing {
+	session, ok := g.standings[roomID]
+	if !ok {
+		return []standing{}
+	}
+	list := make([]standing, 0, len(session.Players))
+	for _, s := range session.Players {
+		list = append(list, *s)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].TotalScore != list[j].TotalScore {
+			return list[i].TotalScore > list[j].TotalScore
+		}
+		return list[i].Wins > list[j].Wins
+	})
+	return list
+}
+
+// rematchTally, oylamaya katılabilecek (biten oyunun hâlâ bağlı) oyuncular üzerinden sonucu hesaplar.
+// Salt çoğunluk yeterlidir.
+func (g *GameHub) rematchTally(roomID uuid.UUID) rematchTally {
+	g.rematchMutex.Lock()
+	pg, ok := g.postGames[roomID]
+	if !ok {
+		g.rematchMutex.Unlock()
+		return rematchTally{}
+	}
+	players := make([]uuid.UUID, 0, len(pg.Players))
+	for id := range pg.Players {
+		players = append(players, id)
+	}
+	votes := make(map[uuid.UUID]bool, len(pg.Votes))
+	for id, v := range pg.Votes {
+		votes[id] = v
+	}
+	g.rematchMutex.Unlock()
+
+	var tally rematchTally
+	for _, id := range players {
+		if !g.hub.isClientConnected(roomID, id) {
+			continue
+		}
+		tally.Eligible++
+		if vote, voted := votes[id]; voted {
+			if vote {
+				tally.Yes++
+			} else {
+				tally.No++
+			}
+		}
+	}
+	tally.Required = tally.Eligible/2 + 1
+	return tally
+}
+
+// inPostGame, odanın sonuç ekranı/rövanş aşamasında olup olmadığını döner.
+func (g *GameHub) inPostGame(roomID uuid.UUID) bool {
+	g.rematchMutex.Lock()
+	defer g.rematchMutex.Unlock()
+	_, ok := g.postGames[roomID]
+	return ok
+}
+
+// claimRematch, rövanş başlatmayı tek seferlik olarak ayırır. Kontrol ve işaretleme aynı kilit
+// altında yapılır; eşzamanlı oylardan sadece biri true alır.
+func (g *GameHub) claimRematch(roomID uuid.UUID) bool {
+	g.rematchMutex.Lock()
+	defer g.rematchMutex.Unlock()
+	pg, ok := g.postGames[roomID]
+	if !ok || pg.Starting {
+		return false
+	}
+	pg.Starting = true
+	return true
+}
+
+// releaseRematch, rövanş isteği işlendikten sonra ayırmayı kaldırır. Oyun başladıysa sonuç
+// ekranı zaten kapanmıştır; başlatma başarısız olduysa rövanş tekrar başlatılabilir.
+func (g *GameHub) releaseRematch(roomID uuid.UUID) {
+	g.rematchMutex.Lock()
+	if pg, ok := g.postGames[roomID]; ok {
+		pg.Starting = false
+	}
+	g.rematchMutex.Unlock()
+}
+
+// endPostGame, yeni oyun başladığında rövanş oylamasını kapatır. Sıralama korunur.
+func (g *GameHub) endPostGame(roomID uuid.UUID) {
+	g.rematchMutex.Lock()
+	delete(g.postGames, roomID)
+	g.rematchMutex.Unlock()
+}
+
+// forgetStandings, oda silindiğinde oturum sıralamasını ve açık oylamayı temizler.
+func (g *GameHub) forgetStandings(roomID uuid.UUID) {
+	g.rematchMutex.Lock()
+	delete(g.postGames, roomID)
+	delete(g.standings, roomID)
+	g.rematchMutex.Unlock()
+}
+
+// handleRematchVote, biten oyunun oyuncularından gelen "tekrar oyna" oyunu işler.
+// Çoğunluk sağlanınca aynı oyuncular ve ayarlarla yeni oyun başlatılır.
+func (h *Hub) handleRematchVote(client *domain.Client, msg RoomManagerData) {
+	roomID := client.RoomID
+	if h.IsGameActive(roomID) {
+		h.sendErrorToClient(client, "Oyun devam ederken rövanş oylanamaz.")
+		return
+	}
+
+	vote := true
+	if content, ok := msg.Content.(map[string]interface{}); ok {
+		if value, ok := content["vote"].(bool); ok {
+			vote = value
+		}
+	}
+
+	g := h.gameHub
+	g.rematchMutex.Lock()
+	pg, ok := g.postGames[roomID]
+	if !ok {
+		g.rematchMutex.Unlock()
+		h.sendErrorToClient(client, "Oylanacak bir rövanş yok.")
+		return
+	}
+	if !pg.Players[client.ID] {
+		g.rematchMutex.Unlock()
+		h.sendErrorToClient(client, "Sadece son oyunun oyuncuları rövanş oylayabilir.")
+		return
+	}
+	pg.Votes[client.ID] = vote
+	g.rematchMutex.Unlock()
+
+	tally := g.rematchTally(roomID)
+	h.BroadcastMessage(roomID, &Message{
+		Type: "rematch_vote_update",
+		Content: map[string]interface{}{
+			"room_id":  roomID,
+			"voter_id": client.ID,
+			"vote":     vote,
+			"tally":    tally,
+		},
+	})
+
+	if tally.Yes >= tally.Required {
+		h.startRematch(roomID, "vote")
+	}
+}
+
+// handleRematchStart, host'un rövanşı oylama beklemeden başlatmasını işler.
+// İsteğe bağlı game_mode_id ile oyunlar arasında mod değiştirilebilir.
+func (h *Hub) handleRematchStart(client *domain.Client, msg RoomManagerData) {
+	roomID := client.RoomID
+	if h.IsGameActive(roomID) {
+		h.sendErrorToClient(client, "Oyun zaten devam ediyor.")
+		return
+	}
+	if !h.gameHub.inPostGame(roomID) {
+		h.sendErrorToClient(client, "Başlatılacak bir rövanş yok.")
+		return
+	}
+
+	if content, ok := msg.Content.(map[string]interface{}); ok {
+		if modeID, ok := content["game_mode_id"].(float64); ok {
+			if err := h.changeRematchMode(client, int(modeID)); err != nil {
+				h.sendErrorToClient(client, err.Error())
+				return
+			}
+		}
+	}
+
+	h.startRematch(roomID, "host")
+}
+
+// changeRematchMode, rövanştan önce odanın oyun modunu değiştirir ve yeni ayarları yükler.
+func (h *Hub) changeRematchMode(client *domain.Client, gameModeID int) error {
+	roomID := client.RoomID
+	if settings := h.GetRoomSettings(roomID); settings != nil && settings.ModeID == strconv.Itoa(gameModeID) {
+		return nil
+	}
+	if !h.SupportsGameMode(gameModeID) {
+		return errUnsupportedMode
+	}
+
+	ctx, cancel := context.WithTimeout(h.ctx, settingsLoadTimeout)
+	defer cancel()
+	mode, err := h.repo.UpdateRoomGameMode(ctx, roomID, client.ID, gameModeID)
+	if err != nil {
+		log.Printf("Rematch mode change failed for room %s: %v", roomID, err)
+		return err
+	}
+
+	h.gameHub.reloadRoomSettings(roomID)
+	h.BroadcastMessage(roomID, &Message{
+		Type: "game_mode_changed",
+		Content: map[string]interface{}{
+			"room_id":      roomID,
+			"game_mode_id": strconv.Itoa(mode.ID),
+			"mode_name":    mode.Name,
+		},
+	})
+	h.publishLobbyRoom(ctx, roomID)
+	return nil
+}
+
+// startRematch, rövanşı oyun başlatma kuyruğuna ekler. Hazır kontrolü atlanır;
+// oylama veya host kararı hazır olmanın yerine geçer.
+func (h *Hub) startRematch(roomID uuid.UUID, trigger string) {
+	if !h.gameHub.claimRematch(roomID) {
+		return
+	}
+	log.Printf("Rematch starting for room %s (trigger: %s)", roomID, trigger)
+	h.BroadcastMessage(roomID, &Message{
+		Type: "rematch_starting",
+		Content: map[string]interface{}{
+			"room_id": roomID,
+			"trigger": trigger,
+		},
+	})
+
+	h.inboundMessages <- struct {
+		RoomID uuid.UUID
+		Msg    RoomManagerData
+	}{
+		RoomID: roomID,
+		Msg: RoomManagerData{
+			Type:    "game_started",
+			Content: map[string]interface{}{rematchFlag: true},
+		},
+	}
+}
+
+// sendStandings, oturum sıralamasını sadece isteyen client'a gönderir.
+func (h *Hub) sendStandings(client *domain.Client) {
+	g := h.gameHub
+	g.rematchMutex.Lock()
+	standings := g.standingsLocked(client.RoomID)
+	_, postGameOpen := g.postGames[client.RoomID]
+	g.rematchMutex.Unlock()
+
+	content := map[string]interface{}{
+		"room_id":   client.RoomID,
+		"standings": standings,
+		"post_game": postGameOpen,
+	}
+	if postGameOpen {
+		content["tally"] = g.rematchTally(client.RoomID)
+	}
+	if err := h.SendMessageToClient(client, &Message{Type: "standings", Content: content}); err != nil {
+		log.Printf("Failed to send standings to client %s: %v", client.ID, err)
+	}
+}
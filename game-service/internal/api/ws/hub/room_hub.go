@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"game-service/domain"
 	"log"
 	"sync"
 
@@ -72,14 +73,24 @@ func (rm *roomHub) StopSubscriber(roomID uuid.UUID) {
 
 // handleRedisMessage, Redis'ten gelen mesajları tipine göre yönlendirir.
 func (rm *roomHub) handleRedisMessage(roomID uuid.UUID, data RoomManagerData) {
-	
+
 	switch data.Type {
 	case "game_mode_change", "game_settings_update":
 		rm.gameHub.HandleGameMessage(roomID, data)
+	case "room_settings_changed":
+		rm.gameHub.reloadRoomSettings(roomID)
 	case "player_left":
 		rm.handlePlayerLeft(roomID, data)
 	case "player_joined":
 		rm.handlePlayerLeft(roomID, data)
+	case "player_kicked", "player_banned":
+		rm.handlePlayerModerated(roomID, data)
+	case "host_changed":
+		rm.handleHostChanged(roomID, data)
+	case "role_changed":
+		rm.handleRoleChanged(roomID, data)
+	case "player_unbanned", "room_code_changed":
+		rm.hub.BroadcastMessage(roomID, &Message{Type: data.Type, Content: data.Content})
 
 	default:
 		log.Printf("Unknown message content type : %s", data.Type)
@@ -105,6 +116,66 @@ func (rm *roomHub) handlePlayerLeft(roomID uuid.UUID, msg RoomManagerData) {
 	}
 	rm.hub.BroadcastMessage(roomID, message)
 }
+
+// handlePlayerModerated, HTTP üzerinden yapılan kick/ban işlemini hub'a yansıtır.
+func (rm *roomHub) handlePlayerModerated(roomID uuid.UUID, msg RoomManagerData) {
+	content, ok := msg.Content.(map[string]interface{})
+	if !ok {
+		log.Printf("Invalid %s content for room %s", msg.Type, roomID)
+		return
+	}
+	userStr, _ := content["user_id"].(string)
+	userID, err := uuid.Parse(userStr)
+	if err != nil {
+		log.Printf("Invalid user_id in %s for room %s: %v", msg.Type, roomID, err)
+		return
+	}
+	byStr, _ := content["by"].(string)
+	byID, _ := uuid.Parse(byStr)
+
+	rm.hub.RemoveModeratedPlayer(roomID, userID, byID, msg.Type == "player_banned")
+}
+
+// handleHostChanged, HTTP üzerinden gerçekleşen host değişikliğini (ayrılma/devir) hub'a yansıtır.
+func (rm *roomHub) handleHostChanged(roomID uuid.UUID, msg RoomManagerData) {
+	content, ok := msg.Content.(map[string]interface{})
+	if !ok {
+		log.Printf("Invalid host_changed content for room %s", roomID)
+		return
+	}
+	oldStr, _ := content["old_host_id"].(string)
+	newStr, _ := content["new_host_id"].(string)
+	oldHostID, _ := uuid.Parse(oldStr)
+	newHostID, err := uuid.Parse(newStr)
+	if err != nil {
+		log.Printf("Invalid new_host_id in host_changed for room %s: %v", roomID, err)
+		return
+	}
+	reason, _ := content["reason"].(string)
+
+	rm.hub.ApplyHostChange(roomID, oldHostID, newHostID, reason)
+}
+
+// handleRoleChanged, HTTP üzerinden yapılan rol değişikliğini bağlı client'a yansıtır.
+func (rm *roomHub) handleRoleChanged(roomID uuid.UUID, msg RoomManagerData) {
+	content, ok := msg.Content.(map[string]interface{})
+	if !ok {
+		log.Printf("Invalid role_changed content for room %s", roomID)
+		return
+	}
+	userStr, _ := content["user_id"].(string)
+	userID, err := uuid.Parse(userStr)
+	if err != nil {
+		log.Printf("Invalid user_id in role_changed for room %s: %v", roomID, err)
+		return
+	}
+	role, _ := content["role"].(string)
+	byStr, _ := content["by"].(string)
+	byID, _ := uuid.Parse(byStr)
+
+	rm.hub.SetClientRole(roomID, userID, domain.RoomRole(role), byID)
+}
+
 func (rm *roomHub) handlePlayerJoin(roomID uuid.UUID, msg RoomManagerData) {
 	log.Printf("Player join room %s.", roomID)
 
@@ -0,0 +1,226 @@
+package hub
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// settingsLoadTimeout, ayarların veritabanından okunması/yazılması için süre sınırıdır.
+const settingsLoadTimeout = 5 * time.Second
+
+// gameSettingsFromDomain, kalıcı oda ayarlarını hub'ın kullandığı yapıya çevirir.
+func gameSettingsFromDomain(s domain.RoomSettings) *GameSettings {
+	return &GameSettings{
+		ModeName:            s.ModeName,
+		ModeID:              strconv.Itoa(s.GameModeID),
+		TotalRounds:         s.TotalRounds,
+		RoundDuration:       s.RoundDuration,
+		PreparationDuration: s.PreparationDuration,
+		MaxPlayers:          s.MaxPlayers,
+		MinPlayers:          s.MinPlayers,
+		AllowSpectators:     s.AllowSpectators,
+		SpectatorChat:       s.SpectatorChat,
+		AllowLateJoin:       s.AllowLateJoin,
+		LateJoinScore:       s.LateJoinScore,
+		MaxPauseDuration:    s.MaxPauseDuration,
+		PauseTimeoutAction:  s.PauseTimeoutAction,
+		VoteKickMajority:    s.VoteKickMajority,
+		VoteKickDuration:    s.VoteKickDuration,
+		VoteKickCooldown:    s.VoteKickCooldown,
+		RequireReady:        s.RequireReady,
+		AutoStartCountdown:  s.AutoStartCountdown,
+		SkipPenalty:         s.SkipPenalty,
+		DrawerIdleTimeout:   s.DrawerIdleTimeout,
+		AfkRoundsLimit:      s.AfkRoundsLimit,
+		WordSource:          s.WordSource,
+		WordsPerPlayer:      s.WordsPerPlayer,
+		WordSubmissionTime:  s.WordSubmissionTime,
+		TeamCount:           s.TeamCount,
+		TeamAssignment:      s.TeamAssignment,
+		StealDelay:          s.StealDelay,
+		DescribeDuration:    s.DescribeDuration,
+		VotingDuration:      s.VotingDuration,
+		CanvasLayout:        s.CanvasLayout,
+	}
+}
+
+// settingsPatchFromContent, WS üzerinden gelen ayar mesajını kısmi güncellemeye çevirir.
+// JSON sayıları float64 olarak geldiğinden tam sayı alanlar dönüştürülür.
+func settingsPatchFromContent(data map[string]interface{}) domain.RoomSettingsPatch {
+	var patch domain.RoomSettingsPatch
+	intField := func(key string) *int {
+		if v, ok := data[key].(float64); ok {
+			n := int(v)
+			return &n
+		}
+		return nil
+	}
+	boolField := func(key string) *bool {
+		if v, ok := data[key].(bool); ok {
+			return &v
+		}
+		return nil
+	}
+	stringField := func(key string) *string {
+		if v, ok := data[key].(string); ok {
+			return &v
+		}
+		return nil
+	}
+
+	patch.TotalRounds = intField("total_rounds")
+	patch.RoundDuration = intField("round_duration")
+	patch.PreparationDuration = intField("preparation_duration")
+	patch.MaxPlayers = intField("max_players")
+	patch.MinPlayers = intField("min_players")
+	patch.AllowSpectators = boolField("allow_spectators")
+	patch.SpectatorChat = boolField("spectator_chat")
+	patch.AllowLateJoin = boolField("allow_late_join")
+	patch.LateJoinScore = stringField("late_join_score")
+	patch.MaxPauseDuration = intField("max_pause_duration")
+	patch.PauseTimeoutAction = stringField("pause_timeout_action")
+	if v, ok := data["vote_kick_majority"].(float64); ok {
+		patch.VoteKickMajority = &v
+	}
+	patch.VoteKickDuration = intField("vote_kick_duration")
+	patch.VoteKickCooldown = intField("vote_kick_cooldown")
+	patch.RequireReady = boolField("require_ready")
+	patch.AutoStartCountdown = intField("auto_start_countdown")
+	patch.SkipPenalty = intField("skip_penalty")
+	patch.DrawerIdleTimeout = intField("drawer_idle_timeout")
+	patch.AfkRoundsLimit = intField("afk_rounds_limit")
+	patch.WordSource = stringField("word_source")
+	patch.WordsPerPlayer = intField("words_per_player")
+	patch.WordSubmissionTime = intField("word_submission_time")
+	patch.TeamCount = intField("team_count")
+	patch.TeamAssignment = stringField("team_assignment")
+	patch.StealDelay = intField("steal_delay")
+	patch.DescribeDuration = intField("describe_duration")
+	patch.VotingDuration = intField("voting_duration")
+	patch.CanvasLayout = stringField("canvas_layout")
+	return patch
+}
+
+// loadRoomSettings, odanın ayarlarını önbellekte yoksa veritabanından yükler.
+// Oda bulunamazsa veya okuma başarısız olursa nil döner.
+func (g *GameHub) loadRoomSettings(roomID uuid.UUID) *GameSettings {
+	g.mutex.RLock()
+	settings, exists := g.roomSettings[roomID]
+	g.mutex.RUnlock()
+	if exists {
+		return settings
+	}
+
+	ctx, cancel := context.WithTimeout(g.hub.ctx, settingsLoadTimeout)
+	defer cancel()
+	stored, err := g.hub.repo.GetRoomSettings(ctx, roomID)
+	if err != nil {
+		log.Printf("Failed to load settings for room %s: %v", roomID, err)
+		return nil
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	// Biz okurken başka bir güncelleme önbelleğe yazmış olabilir; onu ezme
+	if settings, exists := g.roomSettings[roomID]; exists {
+		return settings
+	}
+	settings = gameSettingsFromDomain(stored)
+	g.roomSettings[roomID] = settings
+	return settings
+}
+
+// defaultSettings, modun oyun modu kataloğundaki varsayılan ayarlarını döner.
+func (g *GameHub) defaultSettings(modeID string) *GameSettings {
+	id, err := strconv.Atoi(modeID)
+	if err != nil {
+		log.Printf("Invalid game mode id %q", modeID)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(g.hub.ctx, settingsLoadTimeout)
+	defer cancel()
+	mode, err := g.hub.repo.GetGameMode(ctx, id)
+	if err != nil {
+		log.Printf("Failed to load game mode %d: %v", id, err)
+		return nil
+	}
+	return gameSettingsFromDomain(mode.DefaultSettings)
+}
+
+// SupportsGameMode, sunucuda verilen mod için bir oyun motoru olup olmadığını döner.
+func (h *Hub) SupportsGameMode(gameModeID int) bool {
+	h.gameHub.mutex.RLock()
+	_, exists := h.gameHub.gameEngines[strconv.Itoa(gameModeID)]
+	h.gameHub.mutex.RUnlock()
+	return exists
+}
+
+// reloadRoomSettings, HTTP üzerinden değişen ayarları veritabanından yeniden okur ve yayınlar.
+func (g *GameHub) reloadRoomSettings(roomID uuid.UUID) {
+	ctx, cancel := context.WithTimeout(g.hub.ctx, settingsLoadTimeout)
+	defer cancel()
+	stored, err := g.hub.repo.GetRoomSettings(ctx, roomID)
+	if err != nil {
+		log.Printf("Failed to reload settings for room %s: %v", roomID, err)
+		return
+	}
+	g.applyRoomSettings(roomID, stored)
+}
+
+// applyRoomSettings, kaydedilmiş ayarları önbelleğe yazar ve odadaki herkese bildirir.
+func (g *GameHub) applyRoomSettings(roomID uuid.UUID, stored domain.RoomSettings) {
+	settings := gameSettingsFromDomain(stored)
+
+	g.mutex.Lock()
+	g.roomSettings[roomID] = settings
+	g.mutex.Unlock()
+
+	g.hub.BroadcastMessage(roomID, &Message{
+		Type: "game_settings_updated",
+		Content: map[string]interface{}{
+			"max_players":          settings.MaxPlayers,
+			"min_players":          settings.MinPlayers,
+			"game_mode_id":         settings.ModeID,
+			"mode_name":            settings.ModeName,
+			"total_rounds":         settings.TotalRounds,
+			"round_duration":       settings.RoundDuration,
+			"preparation_duration": settings.PreparationDuration,
+			"allow_spectators":     settings.AllowSpectators,
+			"spectator_chat":       settings.SpectatorChat,
+			"allow_late_join":      settings.AllowLateJoin,
+			"late_join_score":      settings.LateJoinScore,
+			"max_pause_duration":   settings.MaxPauseDuration,
+			"pause_timeout_action": settings.PauseTimeoutAction,
+			"vote_kick_majority":   settings.VoteKickMajority,
+			"vote_kick_duration":   settings.VoteKickDuration,
+			"vote_kick_cooldown":   settings.VoteKickCooldown,
+			"require_ready":        settings.RequireReady,
+			"auto_start_countdown": settings.AutoStartCountdown,
+			"skip_penalty":         settings.SkipPenalty,
+			"drawer_idle_timeout":  settings.DrawerIdleTimeout,
+			"afk_rounds_limit":     settings.AfkRoundsLimit,
+			"word_source":          settings.WordSource,
+			"words_per_player":     settings.WordsPerPlayer,
+			"word_submission_time": settings.WordSubmissionTime,
+			"team_count":           settings.TeamCount,
+			"team_assignment":      settings.TeamAssignment,
+			"steal_delay":          settings.StealDelay,
+			"describe_duration":    settings.DescribeDuration,
+			"voting_duration":      settings.VotingDuration,
+			"canvas_layout":        settings.CanvasLayout,
+		},
+	})
+
+	// Geri sayım ayarı değişmiş olabilir; lobideyse yeniden değerlendir
+	if !g.IsGameActive(roomID) {
+		go g.hub.refreshLobby(roomID)
+	}
+	fmt.Printf("Oyun ayarları güncellendi - Room: %s\n", roomID)
+}
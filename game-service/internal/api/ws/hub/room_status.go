@@ -0,0 +1,64 @@
+package hub
+
+import (
+	"context"
+	"game-service/domain"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Oyun bittikten sonra sonuç ekranı için beklenen süre; ardından oda tekrar lobiye (waiting) döner.
+const postGameLobbyDelay = 15 * time.Second
+
+type roomStatusUpdate struct {
+	RoomID uuid.UUID
+	Status string
+}
+
+// setRoomStatus, oda durum değişikliğini sıraya ekler. Güncellemeler tek bir worker tarafından
+// sırayla yazılır; böylece hızlı ardışık geçişler (playing → finished) yer değiştirmez.
+// GameHub kilidi tutulurken de çağrıldığı için bloklamaz; kuyruk doluysa güncelleme atlanır.
+func (g *GameHub) setRoomStatus(roomID uuid.UUID, status string) {
+	select {
+	case g.roomStatusUpdates <- roomStatusUpdate{RoomID: roomID, Status: status}:
+	default:
+		log.Printf("WARNING: Room status queue full, update dropped for room %s (status=%s)", roomID, status)
+	}
+}
+
+// runRoomStatusWorker, durum değişikliklerini veritabanına yazar; değişen durumu odaya ve lobiye duyurur.
+func (g *GameHub) runRoomStatusWorker() {
+	for update := range g.roomStatusUpdates {
+		ctx, cancel := context.WithTimeout(g.hub.ctx, 5*time.Second)
+		changed, err := g.hub.repo.UpdateRoomStatus(ctx, update.RoomID, update.Status)
+		if err != nil {
+			log.Printf("ROOM_STATUS_FAIL: room %s -> %s: %v", update.RoomID, update.Status, err)
+			cancel()
+			continue
+		}
+		if changed {
+			g.hub.BroadcastMessage(update.RoomID, &Message{
+				Type: "room_status_changed",
+				Content: map[string]interface{}{
+					"room_id": update.RoomID,
+					"status":  update.Status,
+				},
+			})
+			g.hub.publishLobbyRoom(ctx, update.RoomID)
+		}
+		cancel()
+	}
+}
+
+// scheduleReturnToLobby, sonuç ekranından sonra odayı tekrar katılıma açar.
+// Bu sürede yeni bir oyun başladıysa bir şey yapmaz.
+func (g *GameHub) scheduleReturnToLobby(roomID uuid.UUID) {
+	time.AfterFunc(postGameLobbyDelay, func() {
+		if g.hub.IsGameActive(roomID) {
+			return
+		}
+		g.setRoomStatus(roomID, domain.RoomStatusWaiting)
+	})
+}
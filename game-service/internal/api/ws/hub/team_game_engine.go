@@ -0,0 +1,571 @@
+// hub/team_game_engine.go
+package hub
+
+import (
+	"encoding/json"
+	"fmt"
+	"game-service/domain"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Takım modu puanları
+const (
+	teamGuessScore  = 10 // Takım arkadaşının çizimini bilen oyuncu ve takımı
+	teamDrawerScore = 5  // Çizimi takım arkadaşı tarafından bilinen çizer
+	teamStealScore  = 10 // Rakip takımın çizimini çalan oyuncu ve takımı
+)
+
+// Tur bitiş sebepleri (takım modu)
+const (
+	RoundEndTeamGuessed = "team_guessed"
+	RoundEndStolen      = "stolen"
+)
+
+// teamNames, takımların istemcide gösterilen varsayılan isimleridir.
+var teamNames = []string{"Kırmızı", "Mavi", "Yeşil", "Sarı"}
+
+// TeamGameEngine, takımlı "Çizim ve Tahmin" modunun mantığını uygular. Takımlar sırayla çizer;
+// çizimi sadece takım arkadaşları tahmin edebilir, rakipler ise çalma süresi dolunca tahmine katılır.
+type TeamGameEngine struct {
+	gameHub *GameHub
+}
+
+// Team, takım modundaki bir takımdır. Members, takımın çizer sırasını da belirler.
+type Team struct {
+	ID          int         `json:"id"` // 1'den başlar
+	Name        string      `json:"name"`
+	Members     []uuid.UUID `json:"members"`
+	Score       int         `json:"score"`
+	DrawerIndex int         `json:"-"` // Takım içinde sıradaki çizerin indeksi
+}
+
+// teamStats, bir oyuncunun takım puanına katkısını tutar.
+type teamStats struct {
+	Points         int
+	CorrectGuesses int
+	Steals         int
+	DrawnRounds    int
+}
+
+type TeamGameData struct {
+	Teams          []*Team                  `json:"teams"`
+	PlayerTeam     map[uuid.UUID]int        `json:"player_team"`  // oyuncu -> Teams indeksi
+	DrawingTeam    int                      `json:"drawing_team"` // Bu turda çizen takımın Teams indeksi
+	StealOpen      bool                     `json:"steal_open"`
+	CurrentWord    string                   `json:"-"`
+	RoundHistory   map[int]RoundRecord      `json:"-"`
+	CurrentStrokes []DrawingStroke          `json:"-"`
+	Stats          map[uuid.UUID]*teamStats `json:"-"`
+
+	stealTimer  *time.Timer // Rakiplerin tahmine katılacağı anı bekler
+	roundEnding bool        // Tur bitirilmek üzere; tekrar bitirilmesini engeller
+}
+
+func NewTeamGameEngine(gameHub *GameHub) *TeamGameEngine {
+	return &TeamGameEngine{gameHub: gameHub}
+}
+
+// InitGame, oyuncuları takımlara ayırır ve ilk takımın ilk çizerini belirler.
+// Host takım seçtiyse (game.TeamAssignments) seçimler korunur, seçilmeyen oyuncular en az
+// üyeli takımlara dağıtılır. Her takımda en az iki oyuncu olmalıdır.
+func (tge *TeamGameEngine) InitGame(game *Game, players []*Player) error {
+	teamCount := max(game.TeamCount, domain.MinTeamCount)
+	teamCount = min(teamCount, domain.MaxTeamCount, len(players)/2)
+	if teamCount < domain.MinTeamCount {
+		return fmt.Errorf("team mode needs at least %d players", domain.MinTeamCount*2)
+	}
+
+	teamData := &TeamGameData{
+		Teams:          make([]*Team, teamCount),
+		PlayerTeam:     make(map[uuid.UUID]int, len(players)),
+		RoundHistory:   make(map[int]RoundRecord),
+		CurrentStrokes: []DrawingStroke{},
+		Stats:          make(map[uuid.UUID]*teamStats, len(players)),
+	}
+	for i := range teamData.Teams {
+		teamData.Teams[i] = &Team{ID: i + 1, Name: teamNames[i], Members: []uuid.UUID{}}
+	}
+
+	unassigned := make([]*Player, 0, len(players))
+	for _, p := range players {
+		p.Score = 0
+		teamData.Stats[p.UserID] = &teamStats{}
+		if team := game.TeamAssignments[p.UserID]; team >= 1 && team <= teamCount {
+			teamData.addMember(team-1, p.UserID)
+		} else {
+			unassigned = append(unassigned, p)
+		}
+	}
+	// Otomatik dağıtımda takımlar rastgele ve dengeli kurulur
+	rand.Shuffle(len(unassigned), func(i, j int) {
+		unassigned[i], unassigned[j] = unassigned[j], unassigned[i]
+	})
+	for _, p := range unassigned {
+		teamData.addMember(teamData.smallestTeam(), p.UserID)
+	}
+	for _, team := range teamData.Teams {
+		if len(team.Members) < 2 {
+			return fmt.Errorf("team %s needs at least 2 players", team.Name)
+		}
+	}
+
+	game.Players = players
+	game.State = GameStateInProgress
+	game.TurnCount = 1
+	game.CurrentDrawerIndex = 0
+	game.ModeData = teamData
+	teamData.DrawingTeam = 0
+	game.ActivePlayer = teamData.nextDrawer(game, 0)
+
+	tge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type: "teams_assigned",
+		Content: map[string]interface{}{
+			"room_id": game.RoomID,
+			"teams":   teamData.Teams,
+		},
+	})
+	log.Printf("Initialized team game for room %s with %d teams. First drawer: %s", game.RoomID, teamCount, game.ActivePlayer)
+	return nil
+}
+
+// addMember, oyuncuyu takıma ekler.
+func (teamData *TeamGameData) addMember(teamIndex int, userID uuid.UUID) {
+	team := teamData.Teams[teamIndex]
+	team.Members = append(team.Members, userID)
+	teamData.PlayerTeam[userID] = teamIndex
+}
+
+// smallestTeam, en az üyeli takımın indeksini döner (eşitlikte ilk takım).
+func (teamData *TeamGameData) smallestTeam() int {
+	smallest := 0
+	for i, team := range teamData.Teams {
+		if len(team.Members) < len(teamData.Teams[smallest].Members) {
+			smallest = i
+		}
+	}
+	return smallest
+}
+
+// syncLateJoiners, oyuna sonradan katılan oyuncuları en az üyeli takıma ekler.
+// game.Mutex tutulurken çağrılır.
+func (tge *TeamGameEngine) syncLateJoiners(game *Game, teamData *TeamGameData) {
+	for _, p := range game.Players {
+		if _, ok := teamData.PlayerTeam[p.UserID]; ok {
+			continue
+		}
+		teamIndex := teamData.smallestTeam()
+		teamData.addMember(teamIndex, p.UserID)
+		teamData.Stats[p.UserID] = &teamStats{}
+		tge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+			Type: "team_member_added",
+			Content: map[string]interface{}{
+				"user_id": p.UserID,
+				"team_id": teamData.Teams[teamIndex].ID,
+			},
+		})
+	}
+}
+
+// nextDrawer, takımın sıradaki çizerini döner ve takımın çizer sırasını ilerletir.
+// Oyundan ayrılan üyeler atlanır; takımda kimse kalmadıysa uuid.Nil döner.
+func (teamData *TeamGameData) nextDrawer(game *Game, teamIndex int) uuid.UUID {
+	team := teamData.Teams[teamIndex]
+	present := make(map[uuid.UUID]bool, len(game.Players))
+	for _, p := range game.Players {
+		present[p.UserID] = true
+	}
+	for i := 0; i < len(team.Members); i++ {
+		candidate := team.Members[(team.DrawerIndex+i)%len(team.Members)]
+		if present[candidate] {
+			team.DrawerIndex = (team.DrawerIndex + i + 1) % len(team.Members)
+			return candidate
+		}
+	}
+	return uuid.Nil
+}
+
+// ProcessMove, çizim ve tahmin hamlelerini işler.
+func (tge *TeamGameEngine) ProcessMove(game *Game, playerID uuid.UUID, moveData interface{}) error {
+	game.Mutex.Lock()
+	defer game.Mutex.Unlock()
+
+	if game.State != GameStateInProgress {
+		return fmt.Errorf("game is not in progress")
+	}
+	data, ok := moveData.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("invalid move data format")
+	}
+	actionType, ok := data["type"].(string)
+	if !ok {
+		return fmt.Errorf("move data missing 'type' field")
+	}
+	teamData, _ := game.ModeData.(*TeamGameData)
+	if teamData == nil {
+		return fmt.Errorf("oyun modu verisi eksik veya yanlış tipte")
+	}
+
+	switch actionType {
+	case "draw", "canvas_action":
+		if game.ActivePlayer != playerID {
+			return fmt.Errorf("it is not your turn to draw")
+		}
+		jsonData, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("failed to marshal drawing data: %v", err)
+		}
+		teamData.CurrentStrokes = append(teamData.CurrentStrokes, DrawingStroke{
+			PlayerID: playerID,
+			Data:     string(jsonData),
+		})
+		tge.gameHub.hub.BroadcastToOthers(game.RoomID, playerID, &Message{
+			Type: "canvas_update",
+			Content: map[string]interface{}{
+				"drawer_id": playerID,
+				"data":      string(jsonData),
+			},
+		})
+	case "guess":
+		guessText, ok := data["text"].(string)
+		if !ok {
+			return fmt.Errorf("guess data missing 'text' field")
+		}
+		return tge.processGuess(game, teamData, playerID, guessText)
+	}
+	return nil
+}
+
+// processGuess, tahmini değerlendirir. Çizen takımın üyeleri her zaman, rakipler sadece çalma
+// süresi açıldıktan sonra tahmin edebilir. İlk doğru tahmin turu bitirir. game.Mutex tutulurken çağrılır.
+func (tge *TeamGameEngine) processGuess(game *Game, teamData *TeamGameData, playerID uuid.UUID, guessText string) error {
+	if playerID == game.ActivePlayer {
+		return fmt.Errorf("drawer cannot guess the word")
+	}
+	tge.syncLateJoiners(game, teamData)
+	teamIndex := teamData.PlayerTeam[playerID]
+	steal := teamIndex != teamData.DrawingTeam
+	if steal && !teamData.StealOpen {
+		return fmt.Errorf("only the drawing team can guess before stealing opens")
+	}
+	if teamData.roundEnding {
+		return nil
+	}
+	if domain.NormalizeWord(guessText) != domain.NormalizeWord(teamData.CurrentWord) {
+		return nil
+	}
+
+	teamData.roundEnding = true
+	teamData.stopStealTimer()
+
+	points := map[uuid.UUID]int{}
+	stats := teamData.Stats[playerID]
+	reason := RoundEndTeamGuessed
+	if steal {
+		reason = RoundEndStolen
+		points[playerID] = teamStealScore
+		teamData.Teams[teamIndex].Score += teamStealScore
+		stats.Steals++
+	} else {
+		points[playerID] = teamGuessScore
+		points[game.ActivePlayer] = teamDrawerScore
+		teamData.Teams[teamIndex].Score += teamGuessScore + teamDrawerScore
+		stats.CorrectGuesses++
+		if drawerStats, ok := teamData.Stats[game.ActivePlayer]; ok {
+			drawerStats.Points += teamDrawerScore
+		}
+	}
+	stats.Points += points[playerID]
+	for _, p := range game.Players {
+		p.Score += points[p.UserID]
+	}
+	tge.recordGuess(game, teamData, playerID, points)
+
+	log.Printf("Player %s guessed the word in room %s (steal: %v)", playerID, game.RoomID, steal)
+	tge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type: "team_guess_correct",
+		Content: map[string]interface{}{
+			"user_id":      playerID,
+			"team_id":      teamData.Teams[teamIndex].ID,
+			"steal":        steal,
+			"word":         teamData.CurrentWord,
+			"points":       points,
+			"teams":        teamData.Teams,
+			"round_number": game.TurnCount,
+		},
+	})
+	go tge.gameHub.handleRoundEnd(game.RoomID, reason)
+	return nil
+}
+
+// recordGuess, doğru tahmini oyun sonu özeti için tur kaydına işler. game.Mutex tutulurken çağrılır.
+func (tge *TeamGameEngine) recordGuess(game *Game, teamData *TeamGameData, guesserID uuid.UUID, points map[uuid.UUID]int) {
+	record, exists := teamData.RoundHistory[game.TurnCount]
+	if !exists {
+		return
+	}
+	guess := domain.GuessRecord{
+		Round:     game.TurnCount,
+		UserID:    guesserID,
+		Order:     len(record.Guesses) + 1,
+		ElapsedMs: time.Since(record.StartedAt).Milliseconds(),
+	}
+	for _, p := range game.Players {
+		if p.UserID == guesserID {
+			guess.Username = p.Username
+			break
+		}
+	}
+	record.Guesses = append(record.Guesses, guess)
+	for userID, score := range points {
+		record.Points[userID] += score
+	}
+	teamData.RoundHistory[game.TurnCount] = record
+}
+
+// StartRound, turun kelimesini seçer, rolleri bildirir ve çalma zamanlayıcısını kurar.
+func (tge *TeamGameEngine) StartRound(game *Game) error {
+	teamData, ok := game.ModeData.(*TeamGameData)
+	if !ok {
+		return fmt.Errorf("mode data is not of expected type TeamGameData")
+	}
+	tge.syncLateJoiners(game, teamData)
+
+	word := defaultWordList[rand.Intn(len(defaultWordList))]
+	teamData.CurrentWord = word
+	teamData.CurrentStrokes = []DrawingStroke{}
+	teamData.StealOpen = false
+	teamData.roundEnding = false
+	teamData.RoundHistory[game.TurnCount] = RoundRecord{
+		Word:       word,
+		DrawerID:   game.ActivePlayer,
+		AllStrokes: []DrawingStroke{},
+		StartedAt:  time.Now(),
+		Guesses:    []domain.GuessRecord{},
+		Points:     make(map[uuid.UUID]int),
+	}
+
+	drawingTeam := teamData.Teams[teamData.DrawingTeam]
+	stealEnabled := game.StealDelay > 0 && game.StealDelay < game.RoundDuration
+	for _, p := range game.Players {
+		if p.UserID == game.ActivePlayer {
+			tge.gameHub.hub.SendMessageToUser(game.RoomID, p.UserID, &Message{
+				Type: "round_start_drawer",
+				Content: map[string]interface{}{
+					"drawer_id": game.ActivePlayer,
+					"team_id":   drawingTeam.ID,
+					"word":      word,
+					"duration":  game.RoundDuration,
+				},
+			})
+			continue
+		}
+		content := map[string]interface{}{
+			"drawer_id": game.ActivePlayer,
+			"team_id":   drawingTeam.ID,
+			"hint":      "____",
+			"duration":  game.RoundDuration,
+			"can_guess": teamData.PlayerTeam[p.UserID] == teamData.DrawingTeam,
+		}
+		if stealEnabled {
+			content["steal_delay"] = game.StealDelay
+		}
+		tge.gameHub.hub.SendMessageToUser(game.RoomID, p.UserID, &Message{
+			Type:    "round_start_guesser",
+			Content: content,
+		})
+	}
+	tge.gameHub.hub.BroadcastToSpectators(game.RoomID, &Message{
+		Type: "round_start_spectator",
+		Content: map[string]interface{}{
+			"drawer_id":    game.ActivePlayer,
+			"team_id":      drawingTeam.ID,
+			"round_number": game.TurnCount,
+			"total_rounds": game.TotalRounds,
+			"duration":     game.RoundDuration,
+		},
+	})
+
+	if stealEnabled {
+		tge.armStealTimer(game, teamData, time.Duration(game.StealDelay)*time.Second)
+	}
+	return nil
+}
+
+// armStealTimer, rakip takımların tahmine katılacağı anı zamanlar. game.Mutex tutulurken çağrılır.
+func (tge *TeamGameEngine) armStealTimer(game *Game, teamData *TeamGameData, delay time.Duration) {
+	teamData.stopStealTimer()
+	round := game.TurnCount
+	teamData.stealTimer = time.AfterFunc(delay, func() {
+		tge.openSteal(game, round)
+	})
+}
+
+// stopStealTimer, varsa çalma zamanlayıcısını durdurur.
+func (teamData *TeamGameData) stopStealTimer() {
+	if teamData.stealTimer != nil {
+		teamData.stealTimer.Stop()
+		teamData.stealTimer = nil
+	}
+}
+
+// openSteal, çalma süresini açar ve herkese duyurur.
+func (tge *TeamGameEngine) openSteal(game *Game, round int) {
+	game.Mutex.Lock()
+	teamData, ok := game.ModeData.(*TeamGameData)
+	if !ok || game.State != GameStateInProgress || game.TurnCount != round ||
+		teamData.roundEnding || teamData.StealOpen {
+		game.Mutex.Unlock()
+		return
+	}
+	if game.IsPaused {
+		// Duraklatma süresi çalma beklemesinden sayılmaz; devam edince açılır
+		tge.armStealTimer(game, teamData, time.Second)
+		game.Mutex.Unlock()
+		return
+	}
+	teamData.StealOpen = true
+	teamData.stealTimer = nil
+	teamID := teamData.Teams[teamData.DrawingTeam].ID
+	game.Mutex.Unlock()
+
+	tge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type: "steal_open",
+		Content: map[string]interface{}{
+			"team_id":      teamID,
+			"round_number": round,
+		},
+	})
+}
+
+// EndRound, turu kaydeder ve sıradaki takımın sıradaki çizerini belirler.
+func (tge *TeamGameEngine) EndRound(game *Game, reason string) bool {
+	teamData, ok := game.ModeData.(*TeamGameData)
+	if !ok {
+		return false
+	}
+	teamData.stopStealTimer()
+	teamData.roundEnding = true
+
+	if record, exists := teamData.RoundHistory[game.TurnCount]; exists {
+		record.AllStrokes = teamData.CurrentStrokes
+		teamData.RoundHistory[game.TurnCount] = record
+		if stats, ok := teamData.Stats[record.DrawerID]; ok {
+			stats.DrawnRounds++
+		}
+	}
+	log.Printf("Team round %d ended in room %s (%s)", game.TurnCount, game.RoomID, reason)
+
+	game.TurnCount++
+	teamData.CurrentStrokes = []DrawingStroke{}
+	if game.TurnCount > game.TotalRounds {
+		game.State = GameStateOver
+		return false
+	}
+
+	// Takımlar sırayla çizer; üyesi kalmayan takım atlanır
+	tge.syncLateJoiners(game, teamData)
+	for i := 1; i <= len(teamData.Teams); i++ {
+		teamIndex := (teamData.DrawingTeam + i) % len(teamData.Teams)
+		if drawer := teamData.nextDrawer(game, teamIndex); drawer != uuid.Nil {
+			teamData.DrawingTeam = teamIndex
+			game.ActivePlayer = drawer
+			break
+		}
+	}
+	return true
+}
+
+// SendPreparationNotifications, oyunculara sıradaki turdaki rollerini bildirir.
+func (tge *TeamGameEngine) SendPreparationNotifications(game *Game) {
+	teamData, ok := game.ModeData.(*TeamGameData)
+	if !ok {
+		return
+	}
+	drawingTeam := teamData.Teams[teamData.DrawingTeam]
+	for _, p := range game.Players {
+		role := "opponent"
+		switch {
+		case p.UserID == game.ActivePlayer:
+			role = "drawer"
+		case teamData.PlayerTeam[p.UserID] == teamData.DrawingTeam:
+			role = "teammate"
+		}
+		tge.gameHub.hub.SendMessageToUser(game.RoomID, p.UserID, &Message{
+			Type: "round_preparation",
+			Content: map[string]interface{}{
+				"role":                 role,
+				"drawer_id":            game.ActivePlayer,
+				"team_id":              drawingTeam.ID,
+				"preparation_duration": game.PreparationDuration,
+				"round_number":         game.TurnCount,
+				"total_rounds":         game.TotalRounds,
+				"message":              fmt.Sprintf("%d saniye içinde %s takımı çizmeye başlayacak!", game.PreparationDuration, drawingTeam.Name),
+			},
+		})
+	}
+	log.Printf("Team preparation notifications sent for room %s. Next drawer: %s (team %d)",
+		game.RoomID, game.ActivePlayer, drawingTeam.ID)
+}
+
+// RoundSummaries, oynanan turların özetini döner.
+func (tge *TeamGameEngine) RoundSummaries(game *Game) []domain.RoundSummary {
+	teamData, ok := game.ModeData.(*TeamGameData)
+	if !ok || teamData == nil {
+		return nil
+	}
+	return roundSummaries(teamData.RoundHistory, game.Players)
+}
+
+// TeamResults, takımların puanlarını ve üyelerin katkılarını özet için döner.
+func (tge *TeamGameEngine) TeamResults(game *Game) []domain.TeamResult {
+	teamData, ok := game.ModeData.(*TeamGameData)
+	if !ok || teamData == nil {
+		return nil
+	}
+	usernames := make(map[uuid.UUID]string, len(game.Players))
+	for _, p := range game.Players {
+		usernames[p.UserID] = p.Username
+	}
+
+	results := make([]domain.TeamResult, 0, len(teamData.Teams))
+	for _, team := range teamData.Teams {
+		result := domain.TeamResult{
+			TeamID:  team.ID,
+			Name:    team.Name,
+			Score:   team.Score,
+			Members: make([]domain.TeamContribution, 0, len(team.Members)),
+		}
+		for _, userID := range team.Members {
+			contribution := domain.TeamContribution{UserID: userID, Username: usernames[userID]}
+			if stats, ok := teamData.Stats[userID]; ok {
+				contribution.Points = stats.Points
+				contribution.CorrectGuesses = stats.CorrectGuesses
+				contribution.Steals = stats.Steals
+				contribution.DrawnRounds = stats.DrawnRounds
+			}
+			result.Members = append(result.Members, contribution)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// SendGameOver, takım sonuçlarını ve oyun sonu özetini yayınlar.
+func (tge *TeamGameEngine) SendGameOver(game *Game, summary *domain.GameSummary) {
+	content := map[string]interface{}{}
+	if teamData, ok := game.ModeData.(*TeamGameData); ok {
+		content["teams"] = teamData.Teams
+	}
+	if summary != nil {
+		content["summary"] = summary
+	}
+	tge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type:    "game_over",
+		Content: content,
+	})
+	log.Printf("Team game over report published for room %s.", game.RoomID)
+}
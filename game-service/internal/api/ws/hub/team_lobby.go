@@ -0,0 +1,82 @@
+package hub
+
+import (
+	"game-service/domain"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// handleTeamAssign, host'un lobide bir oyuncuyu takıma yerleştirmesini işler.
+// team 0 gönderilirse oyuncunun takımı kaldırılır (oyun başlarken otomatik yerleştirilir).
+func (h *Hub) handleTeamAssign(client *domain.Client, msg RoomManagerData) {
+	roomID := client.RoomID
+	if h.IsGameActive(roomID) {
+		h.sendErrorToClient(client, "Oyun devam ederken takımlar değiştirilemez.")
+		return
+	}
+
+	content, ok := msg.Content.(map[string]interface{})
+	if !ok {
+		h.sendErrorToClient(client, "Geçersiz takım bilgisi.")
+		return
+	}
+	targetStr, _ := content["user_id"].(string)
+	targetID, err := uuid.Parse(targetStr)
+	if err != nil {
+		h.sendErrorToClient(client, "Geçersiz oyuncu.")
+		return
+	}
+	teamValue, _ := content["team"].(float64)
+	team := int(teamValue)
+
+	teamCount := domain.MinTeamCount
+	if settings := h.GetRoomSettings(roomID); settings != nil {
+		teamCount = settings.TeamCount
+	}
+	if team < 0 || team > teamCount {
+		h.sendErrorToClient(client, "Geçersiz takım numarası.")
+		return
+	}
+
+	h.mutex.RLock()
+	target, inRoom := h.roomsClients[roomID][targetID]
+	spectator := inRoom && target.IsSpectator
+	h.mutex.RUnlock()
+	if !inRoom || spectator {
+		h.sendErrorToClient(client, "Oyuncu lobide değil.")
+		return
+	}
+
+	h.lobbyMutex.Lock()
+	if h.lobbyTeams[roomID] == nil {
+		h.lobbyTeams[roomID] = make(map[uuid.UUID]int)
+	}
+	if team == 0 {
+		delete(h.lobbyTeams[roomID], targetID)
+	} else {
+		h.lobbyTeams[roomID][targetID] = team
+	}
+	h.lobbyMutex.Unlock()
+
+	log.Printf("Player %s assigned to team %d in room %s by %s", targetID, team, roomID, client.ID)
+	h.refreshLobby(roomID)
+}
+
+// lobbyTeamAssignments, host'un seçtiği takımların bir kopyasını döner (oyuncu -> takım numarası).
+func (h *Hub) lobbyTeamAssignments(roomID uuid.UUID) map[uuid.UUID]int {
+	h.lobbyMutex.Lock()
+	defer h.lobbyMutex.Unlock()
+	assignments := make(map[uuid.UUID]int, len(h.lobbyTeams[roomID]))
+	for id, team := range h.lobbyTeams[roomID] {
+		assignments[id] = team
+	}
+	return assignments
+}
+
+// clearLobbyTeams, oda silindiğinde takım seçimlerini temizler. Takımlar oyunlar arasında korunur.
+func (h *Hub) clearLobbyTeams(roomID uuid.UUID) {
+	h.lobbyMutex.Lock()
+	delete(h.lobbyTeams, roomID)
+	h.lobbyMutex.Unlock()
+}
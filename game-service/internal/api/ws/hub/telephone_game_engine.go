@@ -0,0 +1,420 @@
+// hub/telephone_game_engine.go
+package hub
+
+import (
+	"fmt"
+	"game-service/domain"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kırık Telefon aşama türleri
+const (
+	ChainStepPrompt   = "prompt"   // İlk aşama: oyuncu kendi cümlesini yazar
+	ChainStepDraw     = "draw"     // Önceki cümleyi çizer
+	ChainStepDescribe = "describe" // Önceki çizimi anlatır
+)
+
+// RoundEndAllSubmitted, aşamadaki herkes teslim ettiğinde kullanılan tur bitiş sebebidir.
+const RoundEndAllSubmitted = "all_submitted"
+
+// chainRevealInterval, oyun sonunda zincir adımlarının açılması arasındaki süredir.
+const chainRevealInterval = 3 * time.Second
+
+// TelephoneGameEngine, "Kırık Telefon" modunun mantığını uygular. Her oyuncu bir cümleyle zincir
+// başlatır; zincirler her aşamada bir sonraki oyuncuya geçer ve sırayla çizilip anlatılır.
+// Oyuncular sadece önlerindeki adımı görür; zincirlerin tamamı oyun sonunda açılır.
+type TelephoneGameEngine struct {
+	gameHub *GameHub
+}
+
+// ChainStep, bir zincirdeki tek bir adımdır (cümle, çizim veya anlatım).
+type ChainStep struct {
+	Kind     string          `json:"kind"`
+	PlayerID uuid.UUID       `json:"player_id"`
+	Username string          `json:"username"`
+	Text     string          `json:"text,omitempty"`
+	Strokes  []DrawingStroke `json:"strokes,omitempty"`
+	Skipped  bool            `json:"skipped"` // Oyuncu süre içinde teslim etmedi
+}
+
+// Chain, bir oyuncunun cümlesiyle başlayan adımlar zinciridir.
+type Chain struct {
+	ID      int         `json:"id"`
+	OwnerID uuid.UUID   `json:"owner_id"`
+	Steps   []ChainStep `json:"steps"`
+}
+
+// telephoneDraft, oyuncunun mevcut aşamadaki (henüz zincire işlenmemiş) çalışmasıdır.
+type telephoneDraft struct {
+	Text    string
+	Strokes []DrawingStroke
+	Done    bool
+}
+
+type TelephoneData struct {
+	Phase     int    `json:"phase"`
+	PhaseKind string `json:"phase_kind"`
+	Submitted int    `json:"submitted"` // Bu aşamada teslim eden oyuncu sayısı
+
+	// Zincirler ve taslaklar oyun bitene kadar gizli kalır
+	Order            []uuid.UUID                   `json:"-"` // Zincirlerin dolaşım sırası
+	Chains           []*Chain                      `json:"-"`
+	Drafts           map[uuid.UUID]*telephoneDraft `json:"-"`
+	DrawDuration     int                           `json:"-"`
+	DescribeDuration int                           `json:"-"`
+
+	roundEnding bool // Aşama bitirilmek üzere; tekrar bitirilmesini engeller
+}
+
+func NewTelephoneGameEngine(gameHub *GameHub) *TelephoneGameEngine {
+	return &TelephoneGameEngine{gameHub: gameHub}
+}
+
+// phaseKind, aşama numarasına göre aşama türünü döner: önce cümle, sonra sırayla çizim ve anlatım.
+func phaseKind(phase int) string {
+	switch {
+	case phase <= 1:
+		return ChainStepPrompt
+	case phase%2 == 0:
+		return ChainStepDraw
+	default:
+		return ChainStepDescribe
+	}
+}
+
+// chainFor, oyuncunun verilen aşamada üzerinde çalışacağı zincirin indeksini döner.
+// Zincirler her aşamada bir sonraki oyuncuya geçer; böylece kimse kendi zincirine ilk
+// aşamadan sonra tekrar denk gelmez.
+func (data *TelephoneData) chainFor(playerIndex, phase int) int {
+	n := len(data.Order)
+	return ((playerIndex-(phase-1))%n + n) % n
+}
+
+// InitGame, oyuncu sırasını karıştırır ve her oyuncu için bir zincir açar.
+// Aşama sayısı oyuncu sayısı kadardır; her oyuncu her zincire bir kez katkı verir.
+// Sıra burada sabitlendiği için bu modda geç katılım kabul edilmez (bkz. AddLateJoiner).
+func (tge *TelephoneGameEngine) InitGame(game *Game, players []*Player) error {
+	if len(players) < 3 {
+		return fmt.Errorf("telephone mode needs at least 3 players")
+	}
+
+	data := &TelephoneData{
+		Order:            make([]uuid.UUID, 0, len(players)),
+		Chains:           make([]*Chain, 0, len(players)),
+		Drafts:           make(map[uuid.UUID]*telephoneDraft, len(players)),
+		DrawDuration:     game.RoundDuration,
+		DescribeDuration: game.DescribeDuration,
+	}
+	for _, p := range players {
+		p.Score = 0
+		data.Order = append(data.Order, p.UserID)
+	}
+	rand.Shuffle(len(data.Order), func(i, j int) {
+		data.Order[i], data.Order[j] = data.Order[j], data.Order[i]
+	})
+	for i, userID := range data.Order {
+		data.Chains = append(data.Chains, &Chain{ID: i + 1, OwnerID: userID, Steps: []ChainStep{}})
+	}
+
+	game.Players = players
+	game.State = GameStateInProgress
+	game.TurnCount = 1
+	game.TotalRounds = len(players)
+	game.ActivePlayer = uuid.Nil // Bu modda herkes aynı anda oynar
+	game.RoundDuration = data.DescribeDuration
+	game.ModeData = data
+
+	log.Printf("Initialized telephone game for room %s with %d chains", game.RoomID, len(data.Chains))
+	return nil
+}
+
+// StartRound, aşamayı açar ve her oyuncuya sadece kendi görevini (önceki adımı) gönderir.
+func (tge *TelephoneGameEngine) StartRound(game *Game) error {
+	data, ok := game.ModeData.(*TelephoneData)
+	if !ok {
+		return fmt.Errorf("mode data is not of expected type TelephoneData")
+	}
+
+	phase := game.TurnCount
+	kind := phaseKind(phase)
+	data.Phase = phase
+	data.PhaseKind = kind
+	data.Submitted = 0
+	data.roundEnding = false
+	data.Drafts = make(map[uuid.UUID]*telephoneDraft, len(data.Order))
+	// Aşama süresi türüne göre değişir; tur zamanlayıcısı StartRound'dan sonra kurulur
+	if kind == ChainStepDraw {
+		game.RoundDuration = data.DrawDuration
+	} else {
+		game.RoundDuration = data.DescribeDuration
+	}
+
+	present := tge.presentPlayers(game)
+	for i, userID := range data.Order {
+		if !present[userID] {
+			continue
+		}
+		data.Drafts[userID] = &telephoneDraft{}
+		chain := data.Chains[data.chainFor(i, phase)]
+		content := map[string]interface{}{
+			"phase":        phase,
+			"total_phases": game.TotalRounds,
+			"kind":         kind,
+			"chain_id":     chain.ID,
+			"duration":     game.RoundDuration,
+		}
+		if len(chain.Steps) > 0 {
+			previous := chain.Steps[len(chain.Steps)-1]
+			content["previous_kind"] = previous.Kind
+			content["previous_skipped"] = previous.Skipped
+			if previous.Kind == ChainStepDraw {
+				content["previous_strokes"] = previous.Strokes
+			} else {
+				content["previous_text"] = previous.Text
+			}
+		}
+		tge.gameHub.hub.SendMessageToUser(game.RoomID, userID, &Message{
+			Type:    "telephone_task",
+			Content: content,
+		})
+	}
+	tge.gameHub.hub.BroadcastToSpectators(game.RoomID, &Message{
+		Type: "telephone_phase",
+		Content: map[string]interface{}{
+			"phase":        phase,
+			"total_phases": game.TotalRounds,
+			"kind":         kind,
+			"duration":     game.RoundDuration,
+		},
+	})
+	return nil
+}
+
+// presentPlayers, hâlâ oyunda olan oyuncuları döner.
+func (tge *TelephoneGameEngine) presentPlayers(game *Game) map[uuid.UUID]bool {
+	present := make(map[uuid.UUID]bool, len(game.Players))
+	for _, p := range game.Players {
+		present[p.UserID] = true
+	}
+	return present
+}
+
+// ProcessMove, oyuncunun mevcut aşamadaki çalışmasını işler. Çizimler kimseye yayınlanmaz;
+// sadece teslim sayısı duyurulur.
+func (tge *TelephoneGameEngine) ProcessMove(game *Game, playerID uuid.UUID, moveData interface{}) error {
+	game.Mutex.Lock()
+	defer game.Mutex.Unlock()
+
+	if game.State != GameStateInProgress {
+		return fmt.Errorf("game is not in progress")
+	}
+	moveMap, ok := moveData.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("invalid move data format")
+	}
+	actionType, ok := moveMap["type"].(string)
+	if !ok {
+		return fmt.Errorf("move data missing 'type' field")
+	}
+	data, _ := game.ModeData.(*TelephoneData)
+	if data == nil {
+		return fmt.Errorf("oyun modu verisi eksik veya yanlış tipte")
+	}
+	draft, ok := data.Drafts[playerID]
+	if !ok {
+		return fmt.Errorf("you have no task in this phase")
+	}
+	if draft.Done {
+		return fmt.Errorf("you already submitted this phase")
+	}
+	if data.roundEnding {
+		return fmt.Errorf("phase is already ending")
+	}
+
+	switch actionType {
+	case "draw", "canvas_action":
+		if data.PhaseKind != ChainStepDraw {
+			return fmt.Errorf("this is not a drawing phase")
+		}
+		// Sonraki oyuncu çizeni görmesin diye vuruşa oyuncu kimliği yazılmaz; adımın sahibi
+		// zincir açılırken ChainStep.PlayerID ile gösterilir.
+		strokeData, err := anonymousStrokeData(moveMap)
+		if err != nil {
+			return err
+		}
+		draft.Strokes = append(draft.Strokes, DrawingStroke{Data: strokeData})
+		return nil
+	case "submit_drawing":
+		if data.PhaseKind != ChainStepDraw {
+			return fmt.Errorf("this is not a drawing phase")
+		}
+	case "submit_text":
+		if data.PhaseKind == ChainStepDraw {
+			return fmt.Errorf("this is a drawing phase")
+		}
+		rawText, _ := moveMap["text"].(string)
+		text, err := domain.ValidateChainText(rawText)
+		if err != nil {
+			return err
+		}
+		draft.Text = text
+	default:
+		return nil
+	}
+
+	draft.Done = true
+	// Aşama sırasında oyundan ayrılanların taslakları sayılmaz; yoksa aşama hiç erken bitmez
+	present := tge.presentPlayers(game)
+	submitted, total := 0, 0
+	for userID, d := range data.Drafts {
+		if !present[userID] {
+			continue
+		}
+		total++
+		if d.Done {
+			submitted++
+		}
+	}
+	data.Submitted = submitted
+	tge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type: "telephone_progress",
+		Content: map[string]interface{}{
+			"phase":     data.Phase,
+			"submitted": data.Submitted,
+			"total":     total,
+		},
+	})
+	if data.Submitted >= total {
+		data.roundEnding = true
+		go tge.gameHub.handleRoundEnd(game.RoomID, RoundEndAllSubmitted)
+	}
+	return nil
+}
+
+// EndRound, taslakları zincirlere işler. Teslim edilmeyen cümlelerin yerine rastgele bir kelime
+// konur ki zincir kopmasın; çizim/anlatım aşamalarında yarım kalan çalışma olduğu gibi alınır.
+func (tge *TelephoneGameEngine) EndRound(game *Game, reason string) bool {
+	data, ok := game.ModeData.(*TelephoneData)
+	if !ok {
+		return false
+	}
+	data.roundEnding = true
+
+	usernames := make(map[uuid.UUID]string, len(game.Players))
+	for _, p := range game.Players {
+		usernames[p.UserID] = p.Username
+	}
+
+	phase := game.TurnCount
+	kind := phaseKind(phase)
+	for i, userID := range data.Order {
+		step := ChainStep{Kind: kind, PlayerID: userID, Username: usernames[userID]}
+		draft := data.Drafts[userID]
+		if draft == nil {
+			draft = &telephoneDraft{}
+		}
+		switch kind {
+		case ChainStepPrompt:
+			step.Text = draft.Text
+			if !draft.Done {
+				step.Text = defaultWordList[rand.Intn(len(defaultWordList))]
+				step.Skipped = true
+			}
+		case ChainStepDescribe:
+			step.Text = draft.Text
+			step.Skipped = !draft.Done
+		case ChainStepDraw:
+			step.Strokes = draft.Strokes
+			step.Skipped = len(draft.Strokes) == 0
+		}
+		chain := data.Chains[data.chainFor(i, phase)]
+		chain.Steps = append(chain.Steps, step)
+	}
+	data.Drafts = make(map[uuid.UUID]*telephoneDraft)
+	log.Printf("Telephone phase %d (%s) ended in room %s (%s)", phase, kind, game.RoomID, reason)
+
+	game.TurnCount++
+	if game.TurnCount > game.TotalRounds {
+		game.State = GameStateOver
+		return false
+	}
+	return true
+}
+
+// SendPreparationNotifications, sıradaki aşamanın türünü herkese bildirir.
+func (tge *TelephoneGameEngine) SendPreparationNotifications(game *Game) {
+	kind := phaseKind(game.TurnCount)
+	tge.gameHub.hub.BroadcastMessage(game.RoomID, &Message{
+		Type: "round_preparation",
+		Content: map[string]interface{}{
+			"role":                 kind,
+			"phase":                game.TurnCount,
+			"preparation_duration": game.PreparationDuration,
+			"round_number":         game.TurnCount,
+			"total_rounds":         game.TotalRounds,
+			"message":              fmt.Sprintf("%d saniye içinde sıradaki aşama başlayacak!", game.PreparationDuration),
+		},
+	})
+}
+
+// SendChainReveal, oyun sonu özetini yayınlar ve zincirleri adım adım açar. Odada yeni bir oyun
+// başlarsa açılış yarıda kesilir. Her iki durumda da son mesaj (chain_reveal_finished) tüm
+// zincirleri birlikte içerir; yarıda kesildiyse "interrupted" true olur.
+func (tge *TelephoneGameEngine) SendChainReveal(game *Game, summary *domain.GameSummary) {
+	data, ok := game.ModeData.(*TelephoneData)
+	if !ok {
+		return
+	}
+	chains := data.Chains
+	roomID := game.RoomID
+
+	content := map[string]interface{}{
+		"chain_count":     len(chains),
+		"steps_per_chain": game.TotalRounds,
+	}
+	if summary != nil {
+		content["summary"] = summary
+	}
+	tge.gameHub.hub.BroadcastMessage(roomID, &Message{
+		Type:    "game_over",
+		Content: content,
+	})
+
+	finish := func(interrupted bool) {
+		tge.gameHub.hub.BroadcastMessage(roomID, &Message{
+			Type: "chain_reveal_finished",
+			Content: map[string]interface{}{
+				"chains":      chains,
+				"interrupted": interrupted,
+			},
+		})
+	}
+
+	go func() {
+		for _, chain := range chains {
+			for i, step := range chain.Steps {
+				time.Sleep(chainRevealInterval)
+				if tge.gameHub.hub.IsGameActive(roomID) {
+					log.Printf("Chain reveal interrupted for room %s: new game started", roomID)
+					finish(true)
+					return
+				}
+				tge.gameHub.hub.BroadcastMessage(roomID, &Message{
+					Type: "chain_reveal_step",
+					Content: map[string]interface{}{
+						"chain_id":   chain.ID,
+						"owner_id":   chain.OwnerID,
+						"step_index": i,
+						"step":       step,
+					},
+				})
+			}
+		}
+		finish(false)
+		log.Printf("Chain reveal finished for room %s", roomID)
+	}()
+}
@@ -0,0 +1,276 @@
+package hub
+
+import (
+	"context"
+	"fmt"
+	"game-service/domain"
+	"log"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Oylamanın anlamlı olması için odada bağlı olması gereken en az oyuncu sayısı.
+const voteKickMinPlayers = 3
+
+// VoteKick, bir odada devam eden oyuncu atma oylamasını tutar.
+type VoteKick struct {
+	TargetID    uuid.UUID
+	InitiatorID uuid.UUID
+	Votes       map[uuid.UUID]bool // oy veren -> evet/hayır
+	Majority    float64
+	ExpiresAt   time.Time
+	timer       *time.Timer
+}
+
+// voteKickTally, oylamanın o anki durumunu bağlı oyunculara göre hesaplar.
+type voteKickTally struct {
+	Yes      int
+	No       int
+	Eligible int
+	Required int
+}
+
+// handleVoteKickStart, bir oyuncunun başka bir oyuncuya karşı oylama başlatmasını işler.
+func (h *Hub) handleVoteKickStart(client *domain.Client, msg RoomManagerData) {
+	content, ok := msg.Content.(map[string]interface{})
+	if !ok {
+		h.sendErrorToClient(client, "Geçersiz oylama içeriği.")
+		return
+	}
+	targetStr, _ := content["user_id"].(string)
+	targetID, err := uuid.Parse(targetStr)
+	if err != nil {
+		h.sendErrorToClient(client, "Geçersiz oyuncu ID'si.")
+		return
+	}
+	if targetID == client.ID {
+		h.sendErrorToClient(client, "Kendinize karşı oylama başlatamazsınız.")
+		return
+	}
+
+	roomID := client.RoomID
+	if h.findRoomPlayer(roomID, targetID) == nil {
+		h.sendErrorToClient(client, "Oyuncu odada bulunamadı.")
+		return
+	}
+	if h.GetRoomPlayerCount(roomID) < voteKickMinPlayers {
+		h.sendErrorToClient(client, fmt.Sprintf("Oylama için odada en az %d oyuncu olmalı.", voteKickMinPlayers))
+		return
+	}
+
+	majority, duration, cooldown := 0.5, 30, 60
+	if settings := h.GetRoomSettings(roomID); settings != nil {
+		majority = settings.VoteKickMajority
+		duration = settings.VoteKickDuration
+		cooldown = settings.VoteKickCooldown
+	}
+
+	h.voteMutex.Lock()
+	if _, active := h.voteKicks[roomID]; active {
+		h.voteMutex.Unlock()
+		h.sendErrorToClient(client, "Bu odada zaten devam eden bir oylama var.")
+		return
+	}
+	if until, ok := h.voteKickCooldowns[roomID][client.ID]; ok && time.Now().Before(until) {
+		h.voteMutex.Unlock()
+		h.sendErrorToClient(client, fmt.Sprintf("Yeni bir oylama başlatmak için %d saniye beklemelisiniz.", int(time.Until(until).Seconds())+1))
+		return
+	}
+
+	vote := &VoteKick{
+		TargetID:    targetID,
+		InitiatorID: client.ID,
+		Votes:       map[uuid.UUID]bool{client.ID: true},
+		Majority:    majority,
+		ExpiresAt:   time.Now().Add(time.Duration(duration) * time.Second),
+	}
+	vote.timer = time.AfterFunc(time.Duration(duration)*time.Second, func() {
+		h.resolveVoteKick(roomID, vote, "expired")
+	})
+	h.voteKicks[roomID] = vote
+
+	// Başlatan oyuncu bekleme süresine girer (oylama spam'ini engeller)
+	if h.voteKickCooldowns[roomID] == nil {
+		h.voteKickCooldowns[roomID] = make(map[uuid.UUID]time.Time)
+	}
+	h.voteKickCooldowns[roomID][client.ID] = time.Now().Add(time.Duration(cooldown) * time.Second)
+	h.voteMutex.Unlock()
+
+	tally := h.tallyVoteKick(roomID, vote)
+	log.Printf("Vote-kick started in room %s: %s -> %s", roomID, client.ID, targetID)
+
+	h.BroadcastMessage(roomID, &Message{
+		Type: "vote_kick_started",
+		Content: map[string]interface{}{
+			"room_id":        roomID,
+			"target_id":      targetID,
+			"initiator_id":   client.ID,
+			"duration":       duration,
+			"expires_at":     vote.ExpiresAt,
+			"yes":            tally.Yes,
+			"no":             tally.No,
+			"required_votes": tally.Required,
+		},
+	})
+
+	h.checkVoteKick(roomID, vote)
+}
+
+// handleVoteKickCast, devam eden oylamaya verilen oyu işler.
+func (h *Hub) handleVoteKickCast(client *domain.Client, msg RoomManagerData) {
+	content, ok := msg.Content.(map[string]interface{})
+	if !ok {
+		h.sendErrorToClient(client, "Geçersiz oylama içeriği.")
+		return
+	}
+	yes, ok := content["vote"].(bool)
+	if !ok {
+		h.sendErrorToClient(client, "Oy değeri (vote) true veya false olmalı.")
+		return
+	}
+
+	roomID := client.RoomID
+	h.voteMutex.Lock()
+	vote, active := h.voteKicks[roomID]
+	if !active {
+		h.voteMutex.Unlock()
+		h.sendErrorToClient(client, "Devam eden bir oylama yok.")
+		return
+	}
+	if vote.TargetID == client.ID {
+		h.voteMutex.Unlock()
+		h.sendErrorToClient(client, "Hakkınızdaki oylamada oy kullanamazsınız.")
+		return
+	}
+	vote.Votes[client.ID] = yes
+	h.voteMutex.Unlock()
+
+	tally := h.tallyVoteKick(roomID, vote)
+	h.BroadcastMessage(roomID, &Message{
+		Type: "vote_kick_updated",
+		Content: map[string]interface{}{
+			"room_id":        roomID,
+			"target_id":      vote.TargetID,
+			"voter_id":       client.ID,
+			"yes":            tally.Yes,
+			"no":             tally.No,
+			"required_votes": tally.Required,
+		},
+	})
+
+	h.checkVoteKick(roomID, vote)
+}
+
+// checkVoteKick, oylamanın erken sonuçlanıp sonuçlanmadığını kontrol eder.
+func (h *Hub) checkVoteKick(roomID uuid.UUID, vote *VoteKick) {
+	if h.findRoomPlayer(roomID, vote.TargetID) == nil {
+		h.resolveVoteKick(roomID, vote, "target_left")
+		return
+	}
+
+	tally := h.tallyVoteKick(roomID, vote)
+	switch {
+	case tally.Yes >= tally.Required:
+		h.resolveVoteKick(roomID, vote, "passed")
+	case tally.Eligible-tally.No < tally.Required:
+		// Kalan oylar gelse bile çoğunluk sağlanamaz
+		h.resolveVoteKick(roomID, vote, "rejected")
+	}
+}
+
+// tallyVoteKick, yalnızca odada bağlı olan oyuncuların oylarını sayar.
+func (h *Hub) tallyVoteKick(roomID uuid.UUID, vote *VoteKick) voteKickTally {
+	h.mutex.RLock()
+	voters := make([]uuid.UUID, 0, len(h.roomsClients[roomID]))
+	for id, c := range h.roomsClients[roomID] {
+		if id != vote.TargetID && !c.IsSpectator {
+			voters = append(voters, id)
+		}
+	}
+	h.mutex.RUnlock()
+
+	h.voteMutex.Lock()
+	defer h.voteMutex.Unlock()
+
+	tally := voteKickTally{Eligible: len(voters)}
+	for _, id := range voters {
+		if yes, voted := vote.Votes[id]; voted {
+			if yes {
+				tally.Yes++
+			} else {
+				tally.No++
+			}
+		}
+	}
+	tally.Required = int(math.Floor(float64(tally.Eligible)*vote.Majority)) + 1
+	if tally.Required > tally.Eligible {
+		tally.Required = tally.Eligible
+	}
+	return tally
+}
+
+// resolveVoteKick, oylamayı sonuçlandırır ve geçtiyse oyuncuyu odadan çıkarır.
+// Aynı oylama için yalnızca ilk çağrı etkilidir.
+func (h *Hub) resolveVoteKick(roomID uuid.UUID, vote *VoteKick, outcome string) {
+	h.voteMutex.Lock()
+	if h.voteKicks[roomID] != vote {
+		h.voteMutex.Unlock()
+		return
+	}
+	delete(h.voteKicks, roomID)
+	vote.timer.Stop()
+	h.voteMutex.Unlock()
+
+	tally := h.tallyVoteKick(roomID, vote)
+	if outcome == "expired" && tally.Yes >= tally.Required {
+		// Süre dolduğunda son sayımda çoğunluk sağlanmışsa oylama geçer
+		outcome = "passed"
+	}
+	passed := outcome == "passed"
+
+	h.BroadcastMessage(roomID, &Message{
+		Type: "vote_kick_result",
+		Content: map[string]interface{}{
+			"room_id":        roomID,
+			"target_id":      vote.TargetID,
+			"passed":         passed,
+			"outcome":        outcome,
+			"yes":            tally.Yes,
+			"no":             tally.No,
+			"required_votes": tally.Required,
+		},
+	})
+	log.Printf("Vote-kick in room %s against %s finished: %s (%d/%d)", roomID, vote.TargetID, outcome, tally.Yes, tally.Required)
+
+	if !passed {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(h.ctx, 5*time.Second)
+	defer cancel()
+	newHostID, err := h.repo.LeaveRoom(ctx, roomID, vote.TargetID)
+	if err != nil {
+		log.Printf("VOTE_KICK_FAIL: could not remove %s from room %s: %v", vote.TargetID, roomID, err)
+	}
+
+	h.evictPlayer(roomID, vote.TargetID, "player_vote_kicked", "Oyuncuların oylamasıyla odadan atıldınız.",
+		map[string]interface{}{"yes": tally.Yes, "required_votes": tally.Required})
+
+	if newHostID != uuid.Nil {
+		h.ApplyHostChange(roomID, vote.TargetID, newHostID, "host_vote_kicked")
+	}
+}
+
+// findRoomPlayer, odada bağlı olan (izleyici olmayan) oyuncuyu döner.
+func (h *Hub) findRoomPlayer(roomID, userID uuid.UUID) *domain.Client {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	c, ok := h.roomsClients[roomID][userID]
+	if !ok || c.IsSpectator {
+		return nil
+	}
+	return c
+}
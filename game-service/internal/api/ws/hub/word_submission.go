@@ -0,0 +1,256 @@
+package hub
+
+import (
+	"errors"
+	"game-service/domain"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SubmittedWord, kelime toplama aşamasında bir oyuncunun yazdığı kelimedir.
+type SubmittedWord struct {
+	Word        string    `json:"-"` // Oyun sırasında istemcilere gönderilmez
+	SubmittedBy uuid.UUID `json:"submitted_by"`
+}
+
+// submittedWordsEngine, oyuncuların yazdığı kelimelerle oynanabilen motorların uyguladığı
+// isteğe bağlı arayüzdür.
+type submittedWordsEngine interface {
+	UsesSubmittedWords() bool
+}
+
+// usesSubmittedWords, modun motoru oyuncu kelimelerini destekliyor mu.
+func (g *GameHub) usesSubmittedWords(modeID string) bool {
+	g.mutex.RLock()
+	engine, ok := g.gameEngines[modeID]
+	g.mutex.RUnlock()
+	if !ok {
+		return false
+	}
+	swe, ok := engine.(submittedWordsEngine)
+	return ok && swe.UsesSubmittedWords()
+}
+
+// wordSubmission, oyun başlamadan önceki kelime toplama aşamasıdır.
+type wordSubmission struct {
+	Players        map[uuid.UUID]bool     // Kelime yazması beklenen oyuncular
+	Words          map[uuid.UUID][]string // oyuncu -> kabul edilen (normalize) kelimeler
+	Owners         map[string]uuid.UUID   // normalize kelime -> yazan (tekrarları engellemek için)
+	WordsPerPlayer int
+	Deadline       time.Time
+	Done           bool
+	Pool           []SubmittedWord // Aşama bitince oyuna aktarılacak kelimeler
+	StartContent   interface{}     // Aşama bitince yeniden gönderilecek game_started içeriği (ör. rövanş işareti)
+	timer          *time.Timer
+}
+
+// startWordSubmission, kelime toplama aşamasını açar. Aşama bitince oyun başlatma isteği
+// yeniden kuyruğa eklenir. Aşama zaten açıksa false döner.
+func (g *GameHub) startWordSubmission(roomID uuid.UUID, players []*Player, settings *GameSettings, startContent interface{}) bool {
+	g.wordMutex.Lock()
+	if existing, ok := g.wordSubmissions[roomID]; ok && !existing.Done {
+		g.wordMutex.Unlock()
+		return false
+	}
+
+	duration := time.Duration(settings.WordSubmissionTime) * time.Second
+	sub := &wordSubmission{
+		Players:        make(map[uuid.UUID]bool, len(players)),
+		Words:          make(map[uuid.UUID][]string),
+		Owners:         make(map[string]uuid.UUID),
+		WordsPerPlayer: settings.WordsPerPlayer,
+		Deadline:       time.Now().Add(duration),
+		StartContent:   startContent,
+	}
+	for _, p := range players {
+		sub.Players[p.UserID] = true
+	}
+	sub.timer = time.AfterFunc(duration, func() {
+		g.finishWordSubmission(roomID, "time_up")
+	})
+	g.wordSubmissions[roomID] = sub
+	g.wordMutex.Unlock()
+
+	g.hub.BroadcastMessage(roomID, &Message{
+		Type: "word_submission_started",
+		Content: map[string]interface{}{
+			"room_id":          roomID,
+			"words_per_player": settings.WordsPerPlayer,
+			"duration":         settings.WordSubmissionTime,
+			"deadline":         sub.Deadline,
+		},
+	})
+	log.Printf("Word submission started for room %s (%d players)", roomID, len(players))
+	return true
+}
+
+// handleSubmitWords, oyuncunun gönderdiği kelimeleri filtreler ve kaydeder.
+// Oyuncunun önceki kelimeleri yenileriyle değiştirilir.
+func (h *Hub) handleSubmitWords(client *domain.Client, msg RoomManagerData) {
+	roomID := client.RoomID
+	var raw []interface{}
+	if content, ok := msg.Content.(map[string]interface{}); ok {
+		raw, _ = content["words"].([]interface{})
+	}
+	if len(raw) == 0 {
+		h.sendErrorToClient(client, "En az bir kelime göndermelisiniz.")
+		return
+	}
+
+	g := h.gameHub
+	g.wordMutex.Lock()
+	sub, ok := g.wordSubmissions[roomID]
+	if !ok || sub.Done {
+		g.wordMutex.Unlock()
+		h.sendErrorToClient(client, "Şu anda kelime toplanmıyor.")
+		return
+	}
+	if !sub.Players[client.ID] {
+		g.wordMutex.Unlock()
+		h.sendErrorToClient(client, "Bu oyunda kelime yazamazsınız.")
+		return
+	}
+
+	// Önceki kelimeler bırakılır; yeni liste baştan değerlendirilir
+	for _, word := range sub.Words[client.ID] {
+		delete(sub.Owners, word)
+	}
+	accepted := []string{}
+	rejected := []map[string]string{}
+	for _, item := range raw {
+		text, _ := item.(string)
+		if len(accepted) >= sub.WordsPerPlayer {
+			rejected = append(rejected, map[string]string{"word": text, "reason": "limit_exceeded"})
+			continue
+		}
+		word, err := domain.ValidateSubmittedWord(text)
+		if err != nil {
+			rejected = append(rejected, map[string]string{"word": text, "reason": rejectReason(err)})
+			continue
+		}
+		if _, taken := sub.Owners[word]; taken {
+			rejected = append(rejected, map[string]string{"word": text, "reason": "duplicate"})
+			continue
+		}
+		sub.Owners[word] = client.ID
+		accepted = append(accepted, word)
+	}
+	sub.Words[client.ID] = accepted
+
+	submitted, complete := sub.progress()
+	total := len(sub.Players)
+	wordsPerPlayer := sub.WordsPerPlayer
+	g.wordMutex.Unlock()
+
+	if err := h.SendMessageToClient(client, &Message{
+		Type: "words_accepted",
+		Content: map[string]interface{}{
+			"accepted":  accepted,
+			"rejected":  rejected,
+			"remaining": max(0, wordsPerPlayer-len(accepted)),
+		},
+	}); err != nil {
+		log.Printf("Failed to send word submission result to %s: %v", client.ID, err)
+	}
+	h.BroadcastMessage(roomID, &Message{
+		Type: "word_submission_progress",
+		Content: map[string]interface{}{
+			"room_id":   roomID,
+			"submitted": submitted,
+			"total":     total,
+		},
+	})
+
+	if complete {
+		g.finishWordSubmission(roomID, "all_submitted")
+	}
+}
+
+// progress, kelimelerini tamamlayan oyuncu sayısını ve herkesin bitirip bitirmediğini döner.
+// wordMutex tutulurken çağrılmalıdır.
+func (sub *wordSubmission) progress() (int, bool) {
+	submitted := 0
+	for playerID := range sub.Players {
+		if len(sub.Words[playerID]) >= sub.WordsPerPlayer {
+			submitted++
+		}
+	}
+	return submitted, submitted == len(sub.Players)
+}
+
+// finishWordSubmission, kelime toplamayı kapatır ve oyunu başlatma isteğini yeniden kuyruğa ekler.
+// Zamanlayıcı ve son gönderim aynı anda bitirmeye çalışabilir; sadece ilki işlenir.
+func (g *GameHub) finishWordSubmission(roomID uuid.UUID, reason string) {
+	g.wordMutex.Lock()
+	sub, ok := g.wordSubmissions[roomID]
+	if !ok || sub.Done {
+		g.wordMutex.Unlock()
+		return
+	}
+	sub.Done = true
+	if sub.timer != nil {
+		sub.timer.Stop()
+	}
+	for playerID, words := range sub.Words {
+		for _, word := range words {
+			sub.Pool = append(sub.Pool, SubmittedWord{Word: word, SubmittedBy: playerID})
+		}
+	}
+	wordCount := len(sub.Pool)
+	startContent := sub.StartContent
+	g.wordMutex.Unlock()
+
+	g.hub.BroadcastMessage(roomID, &Message{
+		Type: "word_submission_ended",
+		Content: map[string]interface{}{
+			"room_id":    roomID,
+			"reason":     reason,
+			"word_count": wordCount,
+		},
+	})
+	log.Printf("Word submission finished for room %s: %d words (%s)", roomID, wordCount, reason)
+
+	g.hub.inboundMessages <- struct {
+		RoomID uuid.UUID
+		Msg    RoomManagerData
+	}{
+		RoomID: roomID,
+		Msg:    RoomManagerData{Type: "game_started", Content: startContent},
+	}
+}
+
+// takeWordPool, tamamlanmış kelime toplamanın kelimelerini bir kez teslim eder.
+// Aşama hâlâ sürüyorsa inProgress true döner.
+func (g *GameHub) takeWordPool(roomID uuid.UUID) (pool []SubmittedWord, ready bool, inProgress bool) {
+	g.wordMutex.Lock()
+	defer g.wordMutex.Unlock()
+	sub, ok := g.wordSubmissions[roomID]
+	if !ok {
+		return nil, false, false
+	}
+	if !sub.Done {
+		return nil, false, true
+	}
+	delete(g.wordSubmissions, roomID)
+	return sub.Pool, true, false
+}
+
+// forgetWordSubmission, oda silindiğinde açık kelime toplamayı iptal eder.
+func (g *GameHub) forgetWordSubmission(roomID uuid.UUID) {
+	g.wordMutex.Lock()
+	if sub, ok := g.wordSubmissions[roomID]; ok && sub.timer != nil {
+		sub.timer.Stop()
+	}
+	delete(g.wordSubmissions, roomID)
+	g.wordMutex.Unlock()
+}
+
+// rejectReason, kelime doğrulama hatasını istemcinin gösterebileceği kısa bir koda çevirir.
+func rejectReason(err error) string {
+	if errors.Is(err, domain.ErrWordNotAllowed) {
+		return "not_allowed"
+	}
+	return "invalid"
+}
@@ -14,7 +14,9 @@ type ChannelWebSocketListenUseCase interface {
 }
 
 type PostgresRepository interface {
-	IsMemberAndHostRoom(ctx context.Context, roomID, userID uuid.UUID) (bool, bool, error)
+	GetRoomRole(ctx context.Context, roomID, userID uuid.UUID) (domain.RoomRole, error)
+	IsPublicRoom(ctx context.Context, roomID uuid.UUID) (bool, error)
+	IsBannedFromRoom(ctx context.Context, roomID, userID uuid.UUID) (bool, error)
 }
 type Hub interface {
 	Run(ctx context.Context)
@@ -24,5 +26,9 @@ type Hub interface {
 	IsGameActive(roomID uuid.UUID) bool
 	GetActiveGame(roomID uuid.UUID) *hub.Game
 	IsPlayerInActiveGame(roomID, userID uuid.UUID) bool
+	AddPlayerToActiveGame(roomID, userID uuid.UUID) (*hub.Game, error)
 	BroadcastMessage(roomID uuid.UUID, msg *hub.Message)
+	GetRoomSettings(roomID uuid.UUID) *hub.GameSettings
+	GetSpectatorCount(roomID uuid.UUID) int
+	ServeLobby(conn *websocket.Conn, userID uuid.UUID)
 }
@@ -0,0 +1,26 @@
+package wsUsecase
+
+import (
+	"context"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/google/uuid"
+)
+
+type LobbyFeedUseCase interface {
+	Execute(c *websocket.Conn, ctx context.Context, currentUserID uuid.UUID)
+}
+type lobbyFeedUseCase struct {
+	hub Hub
+}
+
+func NewLobbyFeedUseCase(hub Hub) LobbyFeedUseCase {
+	return &lobbyFeedUseCase{
+		hub: hub,
+	}
+}
+
+// Execute, bağlantıyı lobi akışına ekler; bağlantı kapanana kadar bloklar.
+func (u *lobbyFeedUseCase) Execute(c *websocket.Conn, ctx context.Context, currentUserID uuid.UUID) {
+	u.hub.ServeLobby(c, currentUserID)
+}
@@ -38,14 +38,37 @@ func (u *roomManagerUseCase) Execute(c *websocket.Conn, ctx context.Context, roo
 	}
 
 	// 1. Oda üyeliği kontrolü
-	isMember, isHost, err := u.repository.IsMemberAndHostRoom(ctx, roomID, currentUserID)
-	if err != nil || !isMember {
+	role, err := u.repository.GetRoomRole(ctx, roomID, currentUserID)
+	if err != nil {
 		errMsg := fmt.Sprintf("Authorization error: %v", err)
 		sendErrorToClient(c, errMsg)
 		fmt.Printf("User %s is not a member of room %s: %v\n", currentUserID, roomID, err)
 		c.Close() // ❌ Bağlantıyı kapat
 		return
 	}
+	isMember := role != domain.RoleSpectator
+
+	settings := u.hub.GetRoomSettings(roomID)
+	spectatorsAllowed := settings == nil || settings.AllowSpectators
+	isSpectator := false
+
+	// Üye olmayanlar sadece herkese açık odaları seyirci olarak izleyebilir (banlılar hariç).
+	if !isMember {
+		if isBanned, err := u.repository.IsBannedFromRoom(ctx, roomID, currentUserID); err != nil || isBanned {
+			sendErrorToClient(c, "Bu odaya girişiniz yasaklandı.")
+			c.Close()
+			return
+		}
+
+		isPublic, err := u.repository.IsPublicRoom(ctx, roomID)
+		if err != nil || !isPublic || !spectatorsAllowed {
+			sendErrorToClient(c, "Authorization error: bu odanın üyesi değilsiniz.")
+			fmt.Printf("User %s is not a member of room %s and cannot spectate\n", currentUserID, roomID)
+			c.Close()
+			return
+		}
+		isSpectator = true
+	}
 
 	// 2. Oyun durumu kontrolü
 	if u.hub.IsGameActive(roomID) {
@@ -57,34 +80,63 @@ func (u *roomManagerUseCase) Execute(c *websocket.Conn, ctx context.Context, roo
 		}
 
 		// 🔍 Kullanıcı oyuncu listesinde mi kontrol et
-		if !u.hub.IsPlayerInActiveGame(roomID, currentUserID) {
-			sendErrorToClient(c, "Bu odada zaten bir oyun devam ediyor. Oyun bittikten sonra tekrar deneyin.")
-			c.Close()
-			return
+		lateJoined := false
+		if !isSpectator && !u.hub.IsPlayerInActiveGame(roomID, currentUserID) && settings != nil && settings.AllowLateJoin {
+			// Geç katılım açık: oyuncuyu devam eden oyuna ekle
+			if joinedGame, err := u.hub.AddPlayerToActiveGame(roomID, currentUserID); err == nil {
+				game = joinedGame
+				lateJoined = true
+			} else {
+				fmt.Printf("Late join failed for user %s in room %s: %v\n", currentUserID, roomID, err)
+			}
 		}
 
-		// ✅ Oyuncu zaten oyundaysa, yeniden bağlanmasına izin ver (reconnect durumu)
-		fmt.Printf("Player %s reconnecting to active game in room %s\n", currentUserID, roomID)
-		u.sendGameStateOnConnect(c, isHost, game)
-		u.hub.BroadcastMessage(roomID, &hub.Message{
-			Type: "player_reconnected",
-			Content: map[string]interface{}{
-				"room_id": roomID,
-				"user_id": currentUserID,
-				"message": "Oyuncu tekrar bağlandı",
-			},
-		})
+		if !isSpectator && !lateJoined && !u.hub.IsPlayerInActiveGame(roomID, currentUserID) {
+			if !spectatorsAllowed {
+				sendErrorToClient(c, "Bu odada zaten bir oyun devam ediyor. Oyun bittikten sonra tekrar deneyin.")
+				c.Close()
+				return
+			}
+			// Oyun bitene kadar seyirci olarak bağlan
+			isSpectator = true
+		}
+
+		if isSpectator {
+			fmt.Printf("User %s joining active game in room %s as spectator\n", currentUserID, roomID)
+			u.sendSpectatorStateOnConnect(c, roomID, game)
+		} else if lateJoined {
+			// Geç katılan oyuncuya mevcut turun kelimesi çıkarılmış oyun durumunu gönder
+			fmt.Printf("Player %s late joined active game in room %s\n", currentUserID, roomID)
+			u.sendGameStateOnConnect(c, role, game.LateJoinerSnapshot())
+		} else {
+			// ✅ Oyuncu zaten oyundaysa, yeniden bağlanmasına izin ver (reconnect durumu)
+			fmt.Printf("Player %s reconnecting to active game in room %s\n", currentUserID, roomID)
+			u.sendGameStateOnConnect(c, role, game)
+			u.hub.BroadcastMessage(roomID, &hub.Message{
+				Type: "player_reconnected",
+				Content: map[string]interface{}{
+					"room_id": roomID,
+					"user_id": currentUserID,
+					"message": "Oyuncu tekrar bağlandı",
+				},
+			})
+		}
+	} else if isSpectator {
+		u.sendSpectatorStateOnConnect(c, roomID, nil)
 	} else {
 		// Oyun aktif değil, bekleme durumunu gönder
-		u.sendWaitingStateOnConnect(c, roomID, isHost)
+		u.sendWaitingStateOnConnect(c, roomID, role)
 	}
 
 	// 3. Client'ı Hub'a Kaydet
 	client := &domain.Client{
-		ID:     currentUserID,
-		Conn:   c,
-		RoomID: roomID,
-		Send:   make(chan []byte, 256),
+		ID:          currentUserID,
+		Conn:        c,
+		RoomID:      roomID,
+		Send:        make(chan []byte, 256),
+		IsSpectator: isSpectator,
+		IsMember:    isMember,
+		Role:        role,
 	}
 	fmt.Printf("Registering client %s to room %s\n", currentUserID, roomID)
 	u.hub.RegisterClient(client)
@@ -99,20 +151,22 @@ func (u *roomManagerUseCase) isPlayerInGame(game *hub.Game, userID uuid.UUID) bo
 	}
 	return false
 }
-func (u *roomManagerUseCase) sendGameStateOnConnect(conn *websocket.Conn, isHost bool, game *hub.Game) {
+func (u *roomManagerUseCase) sendGameStateOnConnect(conn *websocket.Conn, role domain.RoomRole, game *hub.Game) {
 
 	// Örnek: Basit bir mesaj tipi gönderelim
 	type GameStatusMessage struct {
-		Type     string    `json:"type"`
-		State    string    `json:"state"`
-		IsHost   bool      `json:"is_host"`
-		GameData *hub.Game `json:"game_data,omitempty"`
+		Type     string          `json:"type"`
+		State    string          `json:"state"`
+		IsHost   bool            `json:"is_host"`
+		Role     domain.RoomRole `json:"role"`
+		GameData *hub.Game       `json:"game_data,omitempty"`
 	}
 
 	msg := GameStatusMessage{
 		Type:     "game_status",
 		State:    game.State,
-		IsHost:   isHost,
+		IsHost:   role == domain.RoleHost,
+		Role:     role,
 		GameData: game,
 	}
 
@@ -121,19 +175,21 @@ func (u *roomManagerUseCase) sendGameStateOnConnect(conn *websocket.Conn, isHost
 	}
 }
 
-func (u *roomManagerUseCase) sendWaitingStateOnConnect(conn *websocket.Conn, roomID uuid.UUID, isHost bool) {
+func (u *roomManagerUseCase) sendWaitingStateOnConnect(conn *websocket.Conn, roomID uuid.UUID, role domain.RoomRole) {
 	// Oyunun bekleme (waiting) durumunda olduğunu bildiren mesaj.
 	type WaitingMessage struct {
-		Type    string    `json:"type"`
-		RoomID  uuid.UUID `json:"room_id"`
-		IsHost  bool      `json:"is_host"`
-		Message string    `json:"message"`
+		Type    string          `json:"type"`
+		RoomID  uuid.UUID       `json:"room_id"`
+		IsHost  bool            `json:"is_host"`
+		Role    domain.RoomRole `json:"role"`
+		Message string          `json:"message"`
 	}
 
 	msg := WaitingMessage{
 		Type:    "room_status",
 		RoomID:  roomID,
-		IsHost:  isHost,
+		IsHost:  role == domain.RoleHost,
+		Role:    role,
 		Message: "Oda hazır, diğer oyuncular bekleniyor.",
 	}
 
@@ -141,3 +197,31 @@ func (u *roomManagerUseCase) sendWaitingStateOnConnect(conn *websocket.Conn, roo
 		fmt.Printf("Failed to send waiting status to client: %v\n", err)
 	}
 }
+
+func (u *roomManagerUseCase) sendSpectatorStateOnConnect(conn *websocket.Conn, roomID uuid.UUID, game *hub.Game) {
+	// Seyirciye salt okunur durum gönderilir; oyun yoksa sadece bekleme bilgisi.
+	type SpectatorMessage struct {
+		Type           string    `json:"type"`
+		RoomID         uuid.UUID `json:"room_id"`
+		IsSpectator    bool      `json:"is_spectator"`
+		SpectatorCount int       `json:"spectator_count"`
+		GameData       *hub.Game `json:"game_data,omitempty"`
+		Message        string    `json:"message"`
+	}
+
+	msg := SpectatorMessage{
+		Type:           "spectator_status",
+		RoomID:         roomID,
+		IsSpectator:    true,
+		SpectatorCount: u.hub.GetSpectatorCount(roomID) + 1,
+		Message:        "Odayı seyirci olarak izliyorsunuz.",
+	}
+	if game != nil {
+		// Gizli kelime ve tur geçmişi seyirciye gönderilmez
+		msg.GameData = game.SpectatorSnapshot()
+	}
+
+	if err := conn.WriteJSON(msg); err != nil {
+		fmt.Printf("Failed to send spectator status to client: %v\n", err)
+	}
+}
@@ -40,8 +40,8 @@ func (a *App) initDependencies() {
 	a.roomRedisManager = InitRoomRedis(a.config)
 	a.messageHandlers = SetupMessageHandlers(a.postgresRepo)
 	a.kafka = SetupMessaging(a.messageHandlers, a.config)
-	a.httpHandlers = SetupHTTPHandlers(a.postgresRepo, a.sessionManager, a.kafka, a.roomRedisManager)
-	a.hub = InitWebsocket(context.Background(), a.sessionManager)
+	a.hub = InitWebsocket(context.Background(), a.sessionManager, a.postgresRepo)
+	a.httpHandlers = SetupHTTPHandlers(a.postgresRepo, a.sessionManager, a.kafka, a.roomRedisManager, a.hub)
 	a.wsHandlers = SetupWSHandlers(a.postgresRepo, a.hub)
 	a.fiberApp = SetupServer(a.config, a.httpHandlers, a.wsHandlers)
 }
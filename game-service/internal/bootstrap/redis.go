@@ -4,6 +4,7 @@ import (
 	"context"
 	"game-service/config"
 	"game-service/internal/initializer"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
@@ -22,4 +23,7 @@ func InitRoomRedis(config config.Config) RoomRedisManager {
 
 type RoomRedisManager interface {
 	PublishMessage(ctx context.Context, roomID uuid.UUID, msgType string, dataContent interface{})
+	PublishLobbyEvent(ctx context.Context, msgType string, roomID uuid.UUID, data interface{})
+	IncrementAttempts(ctx context.Context, key string, window time.Duration) (int64, error)
+	GetAttempts(ctx context.Context, key string) (int64, error)
 }
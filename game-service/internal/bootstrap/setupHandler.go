@@ -10,28 +10,96 @@ import (
 	pb "shared-lib/events"
 )
 
-func SetupHTTPHandlers(postgresRepository PostgresRepository, sessionManager SessionManager, kafka Messaging, roomRedisManager RoomRedisManager) map[string]interface{} {
-	createdRoomeUseCase := httpUsecase.NewCreateRoomUseCase(postgresRepository)
+func SetupHTTPHandlers(postgresRepository PostgresRepository, sessionManager SessionManager, kafka Messaging, roomRedisManager RoomRedisManager, wsHub Hub) map[string]interface{} {
+	createdRoomeUseCase := httpUsecase.NewCreateRoomUseCase(postgresRepository, roomRedisManager, wsHub)
 	createdRoomeHandler := httpHandler.NewCreateRoomHandler(createdRoomeUseCase)
 
-	joinRoomeUseCase := httpUsecase.NewJoinRoomUseCase(postgresRepository, roomRedisManager)
+	joinRoomeUseCase := httpUsecase.NewJoinRoomUseCase(postgresRepository, roomRedisManager, wsHub)
 	joinRoomeHandler := httpHandler.NewJoinRoomHandler(joinRoomeUseCase)
 
 	leaveRoomeUseCase := httpUsecase.NewLeaveRoomUseCase(postgresRepository, roomRedisManager)
 	leaveRoomeHandler := httpHandler.NewLeaveRoomHandler(leaveRoomeUseCase)
 
-	updateRoomeGameModeUseCase := httpUsecase.NewUpdateRoomGamemodeUseCase(postgresRepository, roomRedisManager)
+	updateRoomeGameModeUseCase := httpUsecase.NewUpdateRoomGamemodeUseCase(postgresRepository, roomRedisManager, wsHub)
 	updateRoomeGameModeHandler := httpHandler.NewUpdateRoomGameModeHandler(updateRoomeGameModeUseCase)
 
 	getVisibleRoomsModeUseCase := httpUsecase.NewGetVisibleRoomsUseCase(postgresRepository)
 	getVisibleRoomsModeHandler := httpHandler.NewGetVisibleRoomsHandler(getVisibleRoomsModeUseCase)
 
+	kickPlayerUseCase := httpUsecase.NewKickPlayerUseCase(postgresRepository, roomRedisManager)
+	kickPlayerHandler := httpHandler.NewKickPlayerHandler(kickPlayerUseCase)
+
+	banPlayerUseCase := httpUsecase.NewBanPlayerUseCase(postgresRepository, roomRedisManager)
+	banPlayerHandler := httpHandler.NewBanPlayerHandler(banPlayerUseCase)
+
+	unbanPlayerUseCase := httpUsecase.NewUnbanPlayerUseCase(postgresRepository, roomRedisManager)
+	unbanPlayerHandler := httpHandler.NewUnbanPlayerHandler(unbanPlayerUseCase)
+
+	getBannedPlayersUseCase := httpUsecase.NewGetBannedPlayersUseCase(postgresRepository)
+	getBannedPlayersHandler := httpHandler.NewGetBannedPlayersHandler(getBannedPlayersUseCase)
+
+	setPlayerRoleUseCase := httpUsecase.NewSetPlayerRoleUseCase(postgresRepository, roomRedisManager)
+	setPlayerRoleHandler := httpHandler.NewSetPlayerRoleHandler(setPlayerRoleUseCase)
+
+	transferHostUseCase := httpUsecase.NewTransferHostUseCase(postgresRepository, roomRedisManager)
+	transferHostHandler := httpHandler.NewTransferHostHandler(transferHostUseCase)
+
+	quickPlayUseCase := httpUsecase.NewQuickPlayUseCase(postgresRepository, roomRedisManager, wsHub)
+	quickPlayHandler := httpHandler.NewQuickPlayHandler(quickPlayUseCase)
+
+	joinRoomByCodeUseCase := httpUsecase.NewJoinRoomByCodeUseCase(postgresRepository, roomRedisManager, wsHub)
+	joinRoomByCodeHandler := httpHandler.NewJoinRoomByCodeHandler(joinRoomByCodeUseCase)
+
+	regenerateRoomCodeUseCase := httpUsecase.NewRegenerateRoomCodeUseCase(postgresRepository, roomRedisManager)
+	regenerateRoomCodeHandler := httpHandler.NewRegenerateRoomCodeHandler(regenerateRoomCodeUseCase)
+
+	createRoomInviteUseCase := httpUsecase.NewCreateRoomInviteUseCase(postgresRepository)
+	createRoomInviteHandler := httpHandler.NewCreateRoomInviteHandler(createRoomInviteUseCase)
+
+	getRoomInvitesUseCase := httpUsecase.NewGetRoomInvitesUseCase(postgresRepository)
+	getRoomInvitesHandler := httpHandler.NewGetRoomInvitesHandler(getRoomInvitesUseCase)
+
+	revokeRoomInviteUseCase := httpUsecase.NewRevokeRoomInviteUseCase(postgresRepository)
+	revokeRoomInviteHandler := httpHandler.NewRevokeRoomInviteHandler(revokeRoomInviteUseCase)
+
+	joinRoomByInviteUseCase := httpUsecase.NewJoinRoomByInviteUseCase(postgresRepository, roomRedisManager, wsHub)
+	joinRoomByInviteHandler := httpHandler.NewJoinRoomByInviteHandler(joinRoomByInviteUseCase)
+
+	getRoomSettingsUseCase := httpUsecase.NewGetRoomSettingsUseCase(postgresRepository)
+	getRoomSettingsHandler := httpHandler.NewGetRoomSettingsHandler(getRoomSettingsUseCase)
+
+	updateRoomSettingsUseCase := httpUsecase.NewUpdateRoomSettingsUseCase(postgresRepository, roomRedisManager)
+	updateRoomSettingsHandler := httpHandler.NewUpdateRoomSettingsHandler(updateRoomSettingsUseCase)
+
+	getGameModesUseCase := httpUsecase.NewGetGameModesUseCase(postgresRepository, wsHub)
+	getGameModesHandler := httpHandler.NewGetGameModesHandler(getGameModesUseCase)
+
+	getGameSummariesUseCase := httpUsecase.NewGetGameSummariesUseCase(postgresRepository)
+	getGameSummariesHandler := httpHandler.NewGetGameSummariesHandler(getGameSummariesUseCase)
+
 	return map[string]interface{}{
 		"create-room":           createdRoomeHandler,
 		"join-room":             joinRoomeHandler,
 		"leave-room":            leaveRoomeHandler,
 		"update-room-game-mode": updateRoomeGameModeHandler,
 		"get-rooms":             getVisibleRoomsModeHandler,
+		"kick-player":           kickPlayerHandler,
+		"ban-player":            banPlayerHandler,
+		"unban-player":          unbanPlayerHandler,
+		"get-banned-players":    getBannedPlayersHandler,
+		"set-player-role":       setPlayerRoleHandler,
+		"transfer-host":         transferHostHandler,
+		"quick-play":            quickPlayHandler,
+		"join-by-code":          joinRoomByCodeHandler,
+		"regenerate-room-code":  regenerateRoomCodeHandler,
+		"create-room-invite":    createRoomInviteHandler,
+		"get-room-invites":      getRoomInvitesHandler,
+		"revoke-room-invite":    revokeRoomInviteHandler,
+		"join-by-invite":        joinRoomByInviteHandler,
+		"get-room-settings":     getRoomSettingsHandler,
+		"update-room-settings":  updateRoomSettingsHandler,
+		"get-game-modes":        getGameModesHandler,
+		"get-game-summaries":    getGameSummariesHandler,
 	}
 }
 func SetupMessageHandlers(postgresRepository PostgresRepository) map[pb.MessageType]MessageHandler {
@@ -46,7 +114,11 @@ func SetupWSHandlers(postgresRepository PostgresRepository, wsHub Hub) map[strin
 	roomManager := wsUsecase.NewRoomManagerUseCase(wsHub, postgresRepository)
 
 	roomManagerHandler := wsHandler.NewWebSocketRoomHandler(roomManager)
+
+	lobbyFeed := wsUsecase.NewLobbyFeedUseCase(wsHub)
+	lobbyFeedHandler := wsHandler.NewWebSocketLobbyHandler(lobbyFeed)
 	return map[string]interface{}{
-		"room-connect": roomManagerHandler,
+		"room-connect":  roomManagerHandler,
+		"lobby-connect": lobbyFeedHandler,
 	}
 }
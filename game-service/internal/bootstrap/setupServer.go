@@ -35,15 +35,51 @@ func SetupServer(config config.Config, httpHandlers map[string]interface{}, wsHa
 	leaveRoomHandler := httpHandlers["leave-room"].(*httpGameHandler.LeaveRoomHandler)
 	updateRoomGameModeHandler := httpHandlers["update-room-game-mode"].(*httpGameHandler.UpdateRoomGameModeHandler)
 	getVisibleRoomsModeHandler := httpHandlers["get-rooms"].(*httpGameHandler.GetVisibleRoomsHandler)
+	kickPlayerHandler := httpHandlers["kick-player"].(*httpGameHandler.KickPlayerHandler)
+	banPlayerHandler := httpHandlers["ban-player"].(*httpGameHandler.BanPlayerHandler)
+	unbanPlayerHandler := httpHandlers["unban-player"].(*httpGameHandler.UnbanPlayerHandler)
+	getBannedPlayersHandler := httpHandlers["get-banned-players"].(*httpGameHandler.GetBannedPlayersHandler)
+	setPlayerRoleHandler := httpHandlers["set-player-role"].(*httpGameHandler.SetPlayerRoleHandler)
+	transferHostHandler := httpHandlers["transfer-host"].(*httpGameHandler.TransferHostHandler)
+	quickPlayHandler := httpHandlers["quick-play"].(*httpGameHandler.QuickPlayHandler)
+	joinRoomByCodeHandler := httpHandlers["join-by-code"].(*httpGameHandler.JoinRoomByCodeHandler)
+	regenerateRoomCodeHandler := httpHandlers["regenerate-room-code"].(*httpGameHandler.RegenerateRoomCodeHandler)
+	createRoomInviteHandler := httpHandlers["create-room-invite"].(*httpGameHandler.CreateRoomInviteHandler)
+	getRoomInvitesHandler := httpHandlers["get-room-invites"].(*httpGameHandler.GetRoomInvitesHandler)
+	revokeRoomInviteHandler := httpHandlers["revoke-room-invite"].(*httpGameHandler.RevokeRoomInviteHandler)
+	joinRoomByInviteHandler := httpHandlers["join-by-invite"].(*httpGameHandler.JoinRoomByInviteHandler)
+	getRoomSettingsHandler := httpHandlers["get-room-settings"].(*httpGameHandler.GetRoomSettingsHandler)
+	updateRoomSettingsHandler := httpHandlers["update-room-settings"].(*httpGameHandler.UpdateRoomSettingsHandler)
+	getGameModesHandler := httpHandlers["get-game-modes"].(*httpGameHandler.GetGameModesHandler)
+	getGameSummariesHandler := httpHandlers["get-game-summaries"].(*httpGameHandler.GetGameSummariesHandler)
 
 	app.Post("/create-room", handler.HandleWithFiber[httpGameHandler.CreateRoomRequest, httpGameHandler.CreateRoomResponse](createRoomHandler))
 	app.Post("/join-room/:room_id", handler.HandleWithFiber[httpGameHandler.JoinRoomRequest, httpGameHandler.JoinRoomResponse](joinRoomHandler))
 	app.Post("/leave-room/:room_id", handler.HandleWithFiber[httpGameHandler.LeaveRoomRequest, httpGameHandler.LeaveRoomResponse](leaveRoomHandler))
 	app.Patch("/game-mode/:room_id", handler.HandleWithFiber[httpGameHandler.UpdateRoomGameModeRequest, httpGameHandler.UpdateRoomGameModeResponse](updateRoomGameModeHandler))
 	app.Get("/rooms", handler.HandleWithFiber[httpGameHandler.GetVisibleRoomsRequest, httpGameHandler.GetVisibleRoomsResponse](getVisibleRoomsModeHandler))
+	app.Post("/kick-player/:room_id", handler.HandleWithFiber[httpGameHandler.KickPlayerRequest, httpGameHandler.KickPlayerResponse](kickPlayerHandler))
+	app.Post("/ban-player/:room_id", handler.HandleWithFiber[httpGameHandler.BanPlayerRequest, httpGameHandler.BanPlayerResponse](banPlayerHandler))
+	app.Post("/unban-player/:room_id", handler.HandleWithFiber[httpGameHandler.UnbanPlayerRequest, httpGameHandler.UnbanPlayerResponse](unbanPlayerHandler))
+	app.Get("/banned-players/:room_id", handler.HandleWithFiber[httpGameHandler.GetBannedPlayersRequest, httpGameHandler.GetBannedPlayersResponse](getBannedPlayersHandler))
+	app.Patch("/player-role/:room_id", handler.HandleWithFiber[httpGameHandler.SetPlayerRoleRequest, httpGameHandler.SetPlayerRoleResponse](setPlayerRoleHandler))
+	app.Post("/transfer-host/:room_id", handler.HandleWithFiber[httpGameHandler.TransferHostRequest, httpGameHandler.TransferHostResponse](transferHostHandler))
+	app.Post("/matchmaking/quick-play", handler.HandleWithFiber[httpGameHandler.QuickPlayRequest, httpGameHandler.QuickPlayResponse](quickPlayHandler))
+	app.Post("/join-by-code", handler.HandleWithFiber[httpGameHandler.JoinRoomByCodeRequest, httpGameHandler.JoinRoomByCodeResponse](joinRoomByCodeHandler))
+	app.Post("/regenerate-room-code/:room_id", handler.HandleWithFiber[httpGameHandler.RegenerateRoomCodeRequest, httpGameHandler.RegenerateRoomCodeResponse](regenerateRoomCodeHandler))
+	app.Post("/room-invites/:room_id", handler.HandleWithFiber[httpGameHandler.CreateRoomInviteRequest, httpGameHandler.CreateRoomInviteResponse](createRoomInviteHandler))
+	app.Get("/room-invites/:room_id", handler.HandleWithFiber[httpGameHandler.GetRoomInvitesRequest, httpGameHandler.GetRoomInvitesResponse](getRoomInvitesHandler))
+	app.Delete("/room-invites/:room_id/:invite_id", handler.HandleWithFiber[httpGameHandler.RevokeRoomInviteRequest, httpGameHandler.RevokeRoomInviteResponse](revokeRoomInviteHandler))
+	app.Post("/join-by-invite", handler.HandleWithFiber[httpGameHandler.JoinRoomByInviteRequest, httpGameHandler.JoinRoomByInviteResponse](joinRoomByInviteHandler))
+	app.Get("/rooms/:room_id/settings", handler.HandleWithFiber[httpGameHandler.GetRoomSettingsRequest, httpGameHandler.GetRoomSettingsResponse](getRoomSettingsHandler))
+	app.Patch("/rooms/:room_id/settings", handler.HandleWithFiber[httpGameHandler.UpdateRoomSettingsRequest, httpGameHandler.UpdateRoomSettingsResponse](updateRoomSettingsHandler))
+	app.Get("/game-modes", handler.HandleWithFiber[httpGameHandler.GetGameModesRequest, httpGameHandler.GetGameModesResponse](getGameModesHandler))
+	app.Get("/rooms/:room_id/summaries", handler.HandleWithFiber[httpGameHandler.GetGameSummariesRequest, httpGameHandler.GetGameSummariesResponse](getGameSummariesHandler))
 	wsRoute := app.Group("/ws")
 	gameHandler := wsHandlers["room-connect"].(*wsHandler.WebSocketRoomHandler)
 	wsRoute.Get("/game/:room_id", handler.HandleWithFiberWS[wsHandler.WebSocketRoomRequest](gameHandler))
+	lobbyHandler := wsHandlers["lobby-connect"].(*wsHandler.WebSocketLobbyHandler)
+	wsRoute.Get("/lobby", handler.HandleWithFiberWS[wsHandler.WebSocketLobbyRequest](lobbyHandler))
 
 	return app
 }
@@ -6,6 +6,7 @@ import (
 	"game-service/internal/api/ws/hub"
 	"game-service/internal/initializer"
 
+	"github.com/gofiber/contrib/websocket"
 	"github.com/google/uuid"
 )
 
@@ -17,10 +18,15 @@ type Hub interface {
 	IsGameActive(roomID uuid.UUID) bool
 	GetActiveGame(roomID uuid.UUID) *hub.Game
 	IsPlayerInActiveGame(roomID, userID uuid.UUID) bool
+	AddPlayerToActiveGame(roomID, userID uuid.UUID) (*hub.Game, error)
 	BroadcastMessage(roomID uuid.UUID, msg *hub.Message)
+	GetRoomSettings(roomID uuid.UUID) *hub.GameSettings
+	SupportsGameMode(gameModeID int) bool
+	GetSpectatorCount(roomID uuid.UUID) int
+	ServeLobby(conn *websocket.Conn, userID uuid.UUID)
 }
 
-func InitWebsocket(ctx context.Context, redisRepo SessionManager) Hub {
+func InitWebsocket(ctx context.Context, redisRepo SessionManager, postgresRepo PostgresRepository) Hub {
 	client := redisRepo.GetRedisClient()
-	return initializer.InitWebsocket(ctx, client)
+	return initializer.InitWebsocket(ctx, client, postgresRepo)
 }
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"errors"
+	"game-service/domain"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/contrib/websocket"
@@ -30,7 +31,7 @@ func HandleBasic[R Request, Res Response](handler BasicHandler[R, Res]) fiber.Ha
 		if err != nil {
 			zap.L().Error("Failed to handle request", zap.Error(err))
 
-			return c.Status(status).JSON(fiber.Map{"error": err.Error()})
+			return c.Status(status).JSON(errorBody(err))
 		}
 		return c.JSON(res)
 	}
@@ -52,11 +53,27 @@ func HandleWithFiber[R Request, Res Response](handler FiberHandler[R, Res]) fibe
 
 		if err != nil {
 			zap.L().Error("Failed to handle request", zap.Error(err))
-			return c.Status(status).JSON(fiber.Map{"error": err.Error()})
+			return c.Status(status).JSON(errorBody(err))
 		}
 		return c.JSON(res)
 	}
 }
+
+// errorBody, hata yanıtını oluşturur. Yetki hatalarında istemcinin
+// hangi işlem ve rol için reddedildiğini anlayabilmesi için ek alanlar döner.
+func errorBody(err error) fiber.Map {
+	var permErr *domain.PermissionError
+	if errors.As(err, &permErr) {
+		return fiber.Map{
+			"error":  err.Error(),
+			"code":   "permission_denied",
+			"action": permErr.Action,
+			"role":   permErr.Role,
+		}
+	}
+	return fiber.Map{"error": err.Error()}
+}
+
 func parseRequest[R any](c *fiber.Ctx, req *R) error {
 	if err := c.BodyParser(req); err != nil && !errors.Is(err, fiber.ErrUnprocessableEntity) {
 		return err
@@ -7,10 +7,11 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
-func InitWebsocket(ctx context.Context, client *redis.Client) *gameHub.Hub {
+func InitWebsocket(ctx context.Context, client *redis.Client, repo gameHub.Repository) *gameHub.Hub {
 
-	hub := gameHub.NewHub(client)
+	hub := gameHub.NewHub(client, repo)
+	hub.ResetRuntimeState(ctx)
 	go hub.Run(ctx)
-	//go hub.StartCleanupJob(ctx)
+	go hub.StartCleanupJob(ctx)
 	return hub
 }
@@ -29,10 +29,25 @@ var ProtectedRoutes = map[string][]string{
 		"/leave-room/:room_id",
 		"/game-mode/:room_id",
 		"/rooms",
+		"/kick-player/:room_id",
+		"/ban-player/:room_id",
+		"/unban-player/:room_id",
+		"/banned-players/:room_id",
+		"/player-role/:room_id",
+		"/transfer-host/:room_id",
+		"/matchmaking/quick-play",
+		"/join-by-code",
+		"/regenerate-room-code/:room_id",
+		"/room-invites/:room_id",
+		"/join-by-invite",
+		"/rooms/:room_id/settings",
+		"/rooms/:room_id/summaries",
+		"/game-modes",
 	},
 
 	"wsgame": {
 		"/ws/game/:id",
+		"/ws/lobby",
 	},
 }
 